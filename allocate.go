@@ -0,0 +1,174 @@
+package dosh
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/shopspring/decimal"
+)
+
+// Allocate divides the amount into parts in proportion to the given ratios,
+// such that the parts add up to exactly the original amount.
+//
+// Each part is a whole number of the currency's minor unit. The amount is
+// allocated using the largest remainder method; that is, each part is first
+// rounded towards zero, and any minor units left over are distributed one at a
+// time to the parts that lost the most to rounding. If several parts lost an
+// equal amount, the part with the lowest index is preferred. For example,
+// allocating a USD amount of 100 in the ratio 1:1:1 results in the parts
+// 33.34, 33.33 and 33.33.
+//
+// Ratios are relative to each other; they do not need to add up to 1 or 100.
+// A negative amount is allocated as though it were positive, and then each of
+// the parts is negated.
+//
+// It returns an error if the currency has no minor unit, if the amount is not
+// a whole number of minor units, or if any ratio is negative or all ratios are
+// zero. It panics if no ratios are provided.
+func (a Amount) Allocate(ratios ...decimal.Decimal) ([]Amount, error) {
+	if len(ratios) == 0 {
+		panic("at least one ratio must be provided")
+	}
+
+	units, n, err := toMinorUnits(a)
+	if err != nil {
+		return nil, fmt.Errorf("cannot allocate %s: %w", describe(a), err)
+	}
+
+	weights, err := ratioWeights(ratios)
+	if err != nil {
+		return nil, fmt.Errorf("cannot allocate %s: %w", describe(a), err)
+	}
+
+	parts := allocateUnits(new(big.Int).Abs(units), weights)
+	return fromMinorUnits(a, parts, n, units.Sign() < 0), nil
+}
+
+// toMinorUnits returns a's magnitude as an integer number of its currency's
+// minor unit, along with the number of decimal places used by the minor unit.
+func toMinorUnits(a Amount) (_ *big.Int, n int32, _ error) {
+	n, err := minorUnitsOf(a.CurrencyCode())
+	if err != nil {
+		return nil, 0, err
+	}
+
+	m := a.mag.Shift(n)
+	if !m.IsInteger() {
+		return nil, 0, errors.New("amount is not a whole number of minor units")
+	}
+
+	return m.BigInt(), n, nil
+}
+
+// fromMinorUnits returns amounts in a's currency with magnitudes given as an
+// integer number of minor units with n decimal places, negating each of them
+// if neg is true.
+func fromMinorUnits(a Amount, parts []*big.Int, n int32, neg bool) []Amount {
+	amounts := make([]Amount, len(parts))
+
+	for i, p := range parts {
+		if neg {
+			p.Neg(p)
+		}
+
+		amounts[i] = Amount{
+			cur: a.cur,
+			mag: decimal.NewFromBigInt(p, -n),
+		}
+	}
+
+	return amounts
+}
+
+// ratioWeights returns the given ratios as integer weights with the same
+// relative proportions.
+func ratioWeights(ratios []decimal.Decimal) ([]*big.Int, error) {
+	nonZero := false
+
+	for _, r := range ratios {
+		if r.IsNegative() {
+			return nil, fmt.Errorf("ratio (%s) is negative", r)
+		}
+
+		if !r.IsZero() {
+			nonZero = true
+		}
+	}
+
+	if !nonZero {
+		return nil, errors.New("all ratios are zero")
+	}
+
+	return scaleToIntegers(ratios), nil
+}
+
+// scaleToIntegers returns the given decimals multiplied by the smallest power
+// of 10 that makes all of them integers.
+func scaleToIntegers(values []decimal.Decimal) []*big.Int {
+	exp := int32(0)
+	for _, v := range values {
+		exp = min(exp, v.Exponent())
+	}
+
+	ints := make([]*big.Int, len(values))
+	for i, v := range values {
+		ints[i] = v.Shift(-exp).BigInt()
+	}
+
+	return ints
+}
+
+// allocateUnits divides total into parts in proportion to the given weights
+// using the largest remainder method.
+//
+// total must not be negative, and the weights must not be negative or all
+// zero. Ties are broken in favor of the part with the lowest index.
+func allocateUnits(total *big.Int, weights []*big.Int) []*big.Int {
+	sum := new(big.Int)
+	for _, w := range weights {
+		sum.Add(sum, w)
+	}
+
+	parts := make([]*big.Int, len(weights))
+	rems := make([]*big.Int, len(weights))
+	left := new(big.Int).Set(total)
+
+	for i, w := range weights {
+		parts[i], rems[i] = new(big.Int).QuoRem(
+			new(big.Int).Mul(total, w),
+			sum,
+			new(big.Int),
+		)
+		left.Sub(left, parts[i])
+	}
+
+	// The sum of the remainders is equal to left × sum, and each remainder is
+	// less than sum, so there are always at least as many non-zero remainders
+	// as there are minor units left to distribute.
+	distributeLeftover(parts, left, func(i, j int) bool {
+		return rems[i].Cmp(rems[j]) > 0
+	})
+
+	return parts
+}
+
+// distributeLeftover adds one to each of the first left parts, in the order
+// given by the less function. Parts that compare equal retain their original
+// relative order, such that ties are broken in favor of the lowest index.
+func distributeLeftover(parts []*big.Int, left *big.Int, less func(i, j int) bool) {
+	order := make([]int, len(parts))
+	for i := range order {
+		order[i] = i
+	}
+
+	sort.SliceStable(order, func(i, j int) bool {
+		return less(order[i], order[j])
+	})
+
+	n := left.Int64()
+	for _, i := range order[:n] {
+		parts[i].Add(parts[i], bigOne)
+	}
+}
@@ -0,0 +1,75 @@
+package dosh_test
+
+import (
+	. "github.com/dogmatiq/dosh"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/shopspring/decimal"
+)
+
+// ratios returns a slice of decimal ratios parsed from strings.
+func ratios(values ...string) []decimal.Decimal {
+	var r []decimal.Decimal
+	for _, v := range values {
+		r = append(r, decimal.RequireFromString(v))
+	}
+	return r
+}
+
+// magnitudes returns the magnitudes of the given amounts as strings.
+func magnitudes(amounts []Amount) []string {
+	var m []string
+	for _, a := range amounts {
+		m = append(m, a.Magnitude().String())
+	}
+	return m
+}
+
+var _ = Describe("type Amount (allocation methods)", func() {
+	Describe("func Allocate()", func() {
+		DescribeTable(
+			"it allocates the amount in proportion to the ratios",
+			func(a Amount, r []decimal.Decimal, expect []string) {
+				parts, err := a.Allocate(r...)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(magnitudes(parts)).To(Equal(expect))
+				Expect(Sum(parts...).EqualTo(a)).To(BeTrue())
+
+				for _, p := range parts {
+					Expect(p.CurrencyCode()).To(Equal(a.CurrencyCode()))
+				}
+			},
+			Entry("equal ratios", FromInt("USD", 100), ratios("1", "1", "1"), []string{"33.34", "33.33", "33.33"}),
+			Entry("unequal ratios", FromInt("USD", 100), ratios("70", "20", "10"), []string{"70", "20", "10"}),
+			Entry("fractional ratios", FromString("USD", "0.05"), ratios("0.3", "0.7"), []string{"0.02", "0.03"}),
+			Entry("largest remainder is preferred", FromString("USD", "0.10"), ratios("1", "2", "4"), []string{"0.01", "0.03", "0.06"}),
+			Entry("ties favor the earliest part", FromString("USD", "0.02"), ratios("1", "1", "1"), []string{"0.01", "0.01", "0"}),
+			Entry("zero ratio", FromInt("USD", 10), ratios("1", "0", "1"), []string{"5", "0", "5"}),
+			Entry("single ratio", FromString("USD", "1.23"), ratios("5"), []string{"1.23"}),
+			Entry("zero-decimal currency", FromInt("JPY", 100), ratios("1", "1", "1"), []string{"34", "33", "33"}),
+			Entry("three-decimal currency", FromInt("BHD", 1), ratios("1", "1", "1"), []string{"0.334", "0.333", "0.333"}),
+			Entry("negative amount", FromInt("USD", -100), ratios("1", "1", "1"), []string{"-33.34", "-33.33", "-33.33"}),
+			Entry("zero amount", Zero("USD"), ratios("1", "1"), []string{"0", "0"}),
+		)
+
+		DescribeTable(
+			"it returns an error if the amount can not be allocated",
+			func(a Amount, r []decimal.Decimal, expect string) {
+				_, err := a.Allocate(r...)
+				Expect(err).To(MatchError(expect))
+			},
+			Entry("not a whole number of minor units", FromString("USD", "10.005"), ratios("1", "1"), "cannot allocate USD amount: amount is not a whole number of minor units"),
+			Entry("unknown currency", FromInt("XYZ", 10), ratios("1", "1"), "cannot allocate XYZ amount: currency is not known"),
+			Entry("currency with no minor unit", FromInt("XAU", 10), ratios("1", "1"), "cannot allocate XAU amount: currency has no minor unit"),
+			Entry("negative ratio", FromInt("USD", 10), ratios("1", "-1"), "cannot allocate USD amount: ratio (-1) is negative"),
+			Entry("all ratios are zero", FromInt("USD", 10), ratios("0", "0"), "cannot allocate USD amount: all ratios are zero"),
+		)
+
+		It("panics if no ratios are provided", func() {
+			Expect(func() {
+				FromInt("USD", 10).Allocate()
+			}).To(PanicWith("at least one ratio must be provided"))
+		})
+	})
+})
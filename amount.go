@@ -3,7 +3,8 @@ package dosh
 import (
 	"fmt"
 
-	"github.com/dogmatiq/dosh/internal/currency"
+	"github.com/dogmatiq/dosh/currency"
+	"github.com/dogmatiq/dosh/internal/decfmt"
 	"github.com/shopspring/decimal"
 )
 
@@ -13,11 +14,20 @@ var (
 
 	// unit is a decimal with a value of 1 (one).
 	unit = decimal.NewFromInt(1)
+
+	// two is a decimal with a value of 2 (two).
+	two = decimal.NewFromInt(2)
+
+	// half is a decimal with a value of 0.5 (one half).
+	half = decimal.New(5, -1)
 )
 
 // Amount represents an immutable amount of money in a specific currency.
 //
-// The zero-value represents zero US dollars ($0 USD).
+// The zero-value represents zero in the default currency, which is US dollars
+// ($0 USD) unless changed by calling SetDefaultCurrency(). If the default
+// currency is disabled, the zero-value has no currency, and most operations
+// on it fail with ErrNoCurrency, as described by SetDefaultCurrency().
 //
 // An Amount consists of a currency code and a magnitude.
 //
@@ -29,13 +39,23 @@ var (
 // operation that accepts a currency code will panic if provided with a code
 // that does not meet this criteria. Where possible, currency codes should be an
 // ISO-4217 3-letter code. Non-standard currency codes should begin with an "X".
+//
+// The "XTS" code, which ISO 4217 reserves for testing purposes, is rejected
+// unless test mode is enabled by calling currency.SetTestMode().
+//
+// Stricter validation, such as accepting only ISO-4217 codes, can be enabled
+// by calling currency.SetPolicy(). The policy applies to all constructors and
+// unmarshaling methods.
+//
+// Applications may also define their own currencies, with codes that are not
+// subject to the above criteria, by calling currency.Register().
 type Amount struct {
 	_ [0]func() // prevent comparison with ==
 
 	// cur is the currency code that idenfifies what currency the magnitude is
 	// expressed in.
 	//
-	// An empty string is equivalent to "USD".
+	// An empty string is equivalent to the default currency.
 	cur string
 
 	// mag is the monetary amount, expressed in whatever currency is specified
@@ -109,11 +129,57 @@ func TryFromString(c, m string) (_ Amount, ok bool) {
 	return FromDecimal(c, d), err == nil
 }
 
+// ZeroIn returns an Amount in the currency c with a magnitude of 0 (zero).
+func ZeroIn(c Currency) Amount {
+	return Zero(c.Code())
+}
+
+// UnitIn returns an Amount in the currency c with a magnitude of 1 (one).
+func UnitIn(c Currency) Amount {
+	return Unit(c.Code())
+}
+
+// FromDecimalIn returns an Amount in the currency c with a decimal magnitude.
+func FromDecimalIn(c Currency, m decimal.Decimal) Amount {
+	return FromDecimal(c.Code(), m)
+}
+
+// FromIntIn returns an Amount in the currency c with an integer magnitude.
+func FromIntIn(c Currency, m int) Amount {
+	return FromInt(c.Code(), m)
+}
+
+// FromStringIn returns an Amount in the currency c with a magnitude parsed
+// from a numeric string.
+//
+// m must use integer, decimal or scientific notation, otherwise a panic
+// occurs.
+func FromStringIn(c Currency, m string) Amount {
+	return FromString(c.Code(), m)
+}
+
+// TryFromStringIn returns an Amount in the currency c with a magnitude parsed
+// from a numeric string.
+//
+// m must use integer, decimal or scientific notation, otherwise ok is false,
+// and the returned amount is undefined.
+func TryFromStringIn(c Currency, m string) (_ Amount, ok bool) {
+	return TryFromString(c.Code(), m)
+}
+
+// Currency returns the currency in which the amount is specified.
+func (a Amount) Currency() Currency {
+	return Currency{a.CurrencyCode()}
+}
+
 // CurrencyCode returns the currency code for the currency in which the amount
 // is specified.
+//
+// If a is the zero-value it returns the default currency, which may be empty
+// if the default currency has been disabled.
 func (a Amount) CurrencyCode() string {
 	if len(a.cur) == 0 {
-		return "USD"
+		return DefaultCurrency()
 	}
 
 	return a.cur
@@ -127,12 +193,19 @@ func (a Amount) Magnitude() decimal.Decimal {
 
 // String returns a human-readable representation of the amount, including the
 // currency code.
+//
+// Trailing zeros are omitted from the magnitude. Use Text() to render the
+// magnitude to the precision of the currency's minor unit.
 func (a Amount) String() string {
-	return a.CurrencyCode() + " " + a.mag.String()
+	return a.Text(CompactText)
 }
 
 // GoString returns a string representation of the amount in Go syntax.
 func (a Amount) GoString() string {
+	if a.CurrencyCode() == "" {
+		return "money.Amount{}"
+	}
+
 	if a.mag.IsZero() {
 		return fmt.Sprintf(
 			"money.Zero(%#v)",
@@ -156,24 +229,61 @@ func (a Amount) GoString() string {
 
 // Format implements fmt.Formatter, allowing Amount to be used with fmt.Printf()
 // and its variants.
+//
+// It supports the 'f', 'F', 'e', 'E', 'g', 'G' and 'v' verbs, along with the
+// width, precision and the '+', '-', ' ' and '0' flags, which apply to the
+// magnitude only. The magnitude is formatted exactly, without conversion to a
+// floating-point value.
+//
+// When used with the 'f', 'F', 'e', 'E', 'g' or 'G' verbs, the '#' flag causes
+// the amount to be prefixed with the currency's symbol instead of its code, for
+// example "$10.13" instead of "USD 10.13". If the currency has no known symbol
+// its code is used as usual. Amounts in the "no currency" currency, "XXX", can
+// not be formatted with a symbol.
+//
+// As with other types, the "%#v" verb produces the Go-syntax representation
+// returned by GoString().
 func (a Amount) Format(f fmt.State, verb rune) {
-	switch verb {
-	case 'f', 'F', // decimal notation
-		'e', 'E', // scientific notation
-		'g', 'G', // decimal notation, or scientific for large exponents
-		'v': // "default" format
-		// This is a subset of the verbs are supported by big.Float.Format().
-	default:
+	if verb == 'v' && f.Flag('#') {
+		f.Write([]byte(a.GoString()))
+		return
+	}
+
+	if !decfmt.Supports(verb) {
 		fmt.Fprintf(f, "%%!%c(money.Amount=%s)", verb, a.String())
 		return
 	}
 
-	fmt.Fprintf(f, "%s ", a.CurrencyCode())
-	a.mag.BigFloat().Format(f, verb)
+	if a.CurrencyCode() == "" {
+		fmt.Fprintf(f, "%%!%c(money.Amount=%s)", verb, a.String())
+		return
+	}
+
+	prefix := a.CurrencyCode() + " "
+
+	if f.Flag('#') {
+		i, _ := currency.Lookup(a.CurrencyCode())
+
+		if i.IsNoCurrency {
+			fmt.Fprintf(f, "%%!%c(money.Amount=%s)", verb, a.String())
+			return
+		}
+
+		if i.Symbol != "" {
+			prefix = i.Symbol
+		}
+	}
+
+	f.Write([]byte(prefix))
+	decfmt.Format(f, verb, a.mag)
 }
 
-// assertSameCurrency panics if a and b do not have the same currency.
+// assertSameCurrency panics if a and b do not have the same currency, or if
+// either has no currency.
 func assertSameCurrency(a, b Amount) {
+	assertHasCurrency(a)
+	assertHasCurrency(b)
+
 	if a.CurrencyCode() != b.CurrencyCode() {
 		panic(fmt.Sprintf(
 			"can not operate on amounts in differing currencies (%s vs %s)",
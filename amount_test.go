@@ -142,6 +142,79 @@ var _ = Describe("type Amount", func() {
 		})
 	})
 
+	Describe("func ZeroIn()", func() {
+		It("returns an amount with the correct currency and magnitude", func() {
+			a := ZeroIn(NewCurrency("XYZ"))
+			Expect(a.CurrencyCode()).To(Equal("XYZ"))
+			Expect(a.Magnitude().IsZero()).To(BeTrue())
+		})
+
+		It("panics if the currency is the zero-value", func() {
+			Expect(func() {
+				ZeroIn(Currency{})
+			}).To(PanicWith(MatchError("currency code is empty, codes must consist only of 3 or more uppercase ASCII letters")))
+		})
+	})
+
+	Describe("func UnitIn()", func() {
+		It("returns an amount with the correct currency and magnitude", func() {
+			a := UnitIn(NewCurrency("XYZ"))
+			Expect(a.CurrencyCode()).To(Equal("XYZ"))
+			Expect(a.Magnitude().Equal(decimal.NewFromInt(1))).To(BeTrue())
+		})
+	})
+
+	Describe("func FromDecimalIn()", func() {
+		It("returns an amount with the correct currency and magnitude", func() {
+			m := decimal.NewFromInt(123)
+			a := FromDecimalIn(NewCurrency("XYZ"), m)
+			Expect(a.CurrencyCode()).To(Equal("XYZ"))
+			Expect(a.Magnitude().Equal(m)).To(BeTrue())
+		})
+	})
+
+	Describe("func FromIntIn()", func() {
+		It("returns an amount with the correct currency and magnitude", func() {
+			a := FromIntIn(NewCurrency("XYZ"), 123)
+			Expect(a.CurrencyCode()).To(Equal("XYZ"))
+			Expect(a.Magnitude().Equal(decimal.NewFromInt(123))).To(BeTrue())
+		})
+	})
+
+	Describe("func FromStringIn()", func() {
+		It("returns an amount with the correct currency and magnitude", func() {
+			a := FromStringIn(NewCurrency("XYZ"), "1.23")
+			Expect(a.CurrencyCode()).To(Equal("XYZ"))
+			Expect(a.Magnitude().Equal(decimal.RequireFromString("1.23"))).To(BeTrue())
+		})
+	})
+
+	Describe("func TryFromStringIn()", func() {
+		It("returns an amount with the correct currency and magnitude", func() {
+			a, ok := TryFromStringIn(NewCurrency("XYZ"), "1.23")
+			Expect(ok).To(BeTrue())
+			Expect(a.CurrencyCode()).To(Equal("XYZ"))
+			Expect(a.Magnitude().Equal(decimal.RequireFromString("1.23"))).To(BeTrue())
+		})
+
+		It("returns false if the input is invalid", func() {
+			_, ok := TryFromStringIn(NewCurrency("XYZ"), "<invalid>")
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	Describe("func Currency()", func() {
+		It("returns the currency", func() {
+			a := Zero("XYZ")
+			Expect(a.Currency()).To(Equal(NewCurrency("XYZ")))
+		})
+
+		It("returns USD when called on a zero-value amount", func() {
+			var a Amount
+			Expect(a.Currency()).To(Equal(NewCurrency("USD")))
+		})
+	})
+
 	Describe("func CurrencyCode()", func() {
 		It("returns the currency code", func() {
 			a := Zero("XYZ")
@@ -194,10 +267,52 @@ var _ = Describe("type Amount", func() {
 			Expect(s).To(Equal("XYZ 10.13"))
 		})
 
+		It("formats large magnitudes exactly", func() {
+			a := FromString("USD", "123456789012345678.123456789")
+			s := fmt.Sprintf("%.9f", a)
+			Expect(s).To(Equal("USD 123456789012345678.123456789"))
+		})
+
+		It("rounds exactly to the requested precision", func() {
+			a := FromString("XYZ", "0.015")
+			s := fmt.Sprintf("%.2f", a)
+			Expect(s).To(Equal("XYZ 0.02"))
+		})
+
+		It("applies the width and flags to the magnitude", func() {
+			a := FromString("XYZ", "10.129")
+			s := fmt.Sprintf("%+08.2f", a)
+			Expect(s).To(Equal("XYZ +0010.13"))
+		})
+
 		It("returns a descriptive string if used with an unsupported verb", func() {
 			a := FromString("XYZ", "10.129")
 			s := fmt.Sprintf("%d", a)
 			Expect(s).To(Equal("%!d(money.Amount=XYZ 10.129)"))
 		})
+
+		It("uses the currency symbol if the '#' flag is used", func() {
+			a := FromString("EUR", "10.129")
+			s := fmt.Sprintf("%#0.2f", a)
+			Expect(s).To(Equal("€10.13"))
+		})
+
+		It("uses the currency code if the '#' flag is used and the currency has no symbol", func() {
+			a := FromString("XYZ", "10.129")
+			s := fmt.Sprintf("%#0.2f", a)
+			Expect(s).To(Equal("XYZ 10.13"))
+		})
+
+		It("uses the Go-syntax representation if the '#' flag is used with the 'v' verb", func() {
+			a := FromString("USD", "1.5")
+			s := fmt.Sprintf("%#v", a)
+			Expect(s).To(Equal(`money.FromString("USD", "1.5")`))
+		})
+
+		It("returns a descriptive string if the '#' flag is used with the XXX currency", func() {
+			a := FromString("XXX", "10.129")
+			s := fmt.Sprintf("%#0.2f", a)
+			Expect(s).To(Equal("%!f(money.Amount=XXX 10.129)"))
+		})
 	})
 })
@@ -0,0 +1,44 @@
+package dosh
+
+import (
+	"fmt"
+
+	"github.com/dogmatiq/dosh/currency"
+)
+
+// RoundCash returns the amount rounded for settlement in cash within a country
+// or territory, using the given rounding mode.
+//
+// country is an ISO 3166-1 alpha-2 code, such as "CH". If cash payments in a's
+// currency are rounded to a larger increment within that country, as given by
+// currency.CashIncrement(), the amount is rounded to that increment. For
+// example, a "CHF" amount of 1.23 is rounded to 1.25 in Switzerland.
+// Otherwise, the amount is rounded to the currency's minor unit.
+//
+// adjustment is the difference between the rounded amount and a, such that
+// a.Add(adjustment) is equal to rounded. It is typically displayed as a
+// "rounding" line on a receipt.
+//
+// It returns an error under the same conditions as Amount.RoundToMinorUnit().
+func (a Amount) RoundCash(country string, mode RoundingMode) (rounded, adjustment Amount, err error) {
+	c := a.CurrencyCode()
+	if c == "" {
+		return Amount{}, Amount{}, fmt.Errorf("cannot round amount for cash settlement: %w", ErrNoCurrency)
+	}
+
+	if inc, ok := currency.CashIncrement(country, c); ok {
+		m, err := roundToIncrement(a.mag, inc, mode)
+		if err != nil {
+			return Amount{}, Amount{}, err
+		}
+
+		rounded = Amount{cur: a.cur, mag: m}
+	} else {
+		rounded, err = a.RoundToMinorUnit(mode)
+		if err != nil {
+			return Amount{}, Amount{}, err
+		}
+	}
+
+	return rounded, rounded.Sub(a), nil
+}
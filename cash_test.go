@@ -0,0 +1,55 @@
+package dosh_test
+
+import (
+	. "github.com/dogmatiq/dosh"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("type Amount (cash rounding methods)", func() {
+	Describe("func RoundCash()", func() {
+		DescribeTable(
+			"it returns the amount rounded for cash settlement and the adjustment",
+			func(country, c string, mode RoundingMode, a, expectRounded, expectAdjustment string) {
+				r, adj, err := FromString(c, a).RoundCash(country, mode)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(r.EqualTo(FromString(c, expectRounded))).To(
+					BeTrue(),
+					"%s rounded to %s, expected %s",
+					a,
+					r.Magnitude(),
+					expectRounded,
+				)
+				Expect(adj.EqualTo(FromString(c, expectAdjustment))).To(
+					BeTrue(),
+					"adjustment is %s, expected %s",
+					adj.Magnitude(),
+					expectAdjustment,
+				)
+			},
+			Entry("CHF in Switzerland, rounded up", "CH", "CHF", HalfUp, "1.23", "1.25", "0.02"),
+			Entry("CHF in Switzerland, rounded down", "CH", "CHF", HalfUp, "1.22", "1.20", "-0.02"),
+			Entry("CHF in Switzerland, half", "CH", "CHF", HalfUp, "1.025", "1.05", "0.025"),
+			Entry("CHF in Switzerland, half (banker's)", "CH", "CHF", HalfEven, "1.025", "1.00", "-0.025"),
+			Entry("CHF in Switzerland, negative", "CH", "CHF", HalfUp, "-1.23", "-1.25", "-0.02"),
+			Entry("CHF in Switzerland, already exact", "CH", "CHF", HalfUp, "1.15", "1.15", "0"),
+			Entry("SEK in Sweden", "SE", "SEK", HalfUp, "10.50", "11", "0.50"),
+			Entry("SEK in Sweden, floor", "SE", "SEK", Floor, "10.99", "10", "-0.99"),
+			Entry("DKK in Denmark", "DK", "DKK", HalfUp, "10.30", "10.50", "0.20"),
+			Entry("HUF in Hungary", "HU", "HUF", HalfUp, "1232", "1230", "-2"),
+			Entry("no cash rounding, rounds to minor unit", "US", "USD", HalfUp, "1.234", "1.23", "-0.004"),
+			Entry("currency is not cash-rounded in the country", "CH", "EUR", HalfUp, "1.234", "1.23", "-0.004"),
+		)
+
+		It("returns ErrInexact if the mode is Unnecessary and rounding is required", func() {
+			_, _, err := FromString("CHF", "1.23").RoundCash("CH", Unnecessary)
+			Expect(err).To(Equal(ErrInexact))
+		})
+
+		It("returns an error if the currency has no minor unit and is not cash-rounded", func() {
+			_, _, err := FromString("XAU", "1.5").RoundCash("CH", HalfUp)
+			Expect(err).To(MatchError("cannot round XAU amount to its minor unit: currency has no minor unit"))
+		})
+	})
+})
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"strconv"
+)
+
+// flags is a map of currency code to the additional Info fields that are set
+// for that currency.
+//
+// ISO 4217 defines these codes in its text, but does not identify them in its
+// XML files.
+var flags = map[string]string{
+	"XAG": "IsMetal: true",
+	"XAU": "IsMetal: true",
+	"XPD": "IsMetal: true",
+	"XPT": "IsMetal: true",
+	"XTS": "IsTesting: true",
+	"XXX": "IsNoCurrency: true",
+}
+
+// generate returns the Go source code for the currency package's ISO 4217
+// tables.
+func generate(current, historic table) ([]byte, error) {
+	var buf bytes.Buffer
+
+	buf.WriteString("// Code generated by dosh-gen-currency. DO NOT EDIT.\n\n")
+	buf.WriteString("package currency\n\n")
+	buf.WriteString("import \"time\"\n\n")
+
+	isCurrent := map[string]bool{}
+
+	fmt.Fprintf(&buf, "// iso4217 is the list of currencies currently defined by ISO 4217, as\n")
+	fmt.Fprintf(&buf, "// published on %s.\n", current.Published)
+	buf.WriteString("var iso4217 = []Info{\n")
+
+	for _, e := range current.Entries {
+		isCurrent[e.Code] = true
+
+		minor := "NoMinorUnits"
+		if e.MinorUnits != "N.A." {
+			n, err := strconv.Atoi(e.MinorUnits)
+			if err != nil {
+				return nil, fmt.Errorf("%s has invalid minor units (%s)", e.Code, e.MinorUnits)
+			}
+			minor = strconv.Itoa(n)
+		}
+
+		fmt.Fprintf(
+			&buf,
+			"{Code: %q, NumericCode: %d, MinorUnits: %s, Name: %q",
+			e.Code,
+			e.NumericCode,
+			minor,
+			e.Name,
+		)
+
+		if e.IsFund {
+			buf.WriteString(", IsFund: true")
+		}
+
+		if f, ok := flags[e.Code]; ok {
+			buf.WriteString(", " + f)
+		}
+
+		buf.WriteString("},\n")
+	}
+
+	buf.WriteString("}\n\n")
+
+	fmt.Fprintf(&buf, "// iso4217Historic is the list of currencies that have been withdrawn from\n")
+	fmt.Fprintf(&buf, "// ISO 4217, as published on %s.\n", historic.Published)
+	buf.WriteString("//\n")
+	buf.WriteString("// ISO 4217 does not publish the minor units of historic currencies, see\n")
+	buf.WriteString("// historicMinorUnits.\n")
+	buf.WriteString("var iso4217Historic = []Info{\n")
+
+	for _, e := range historic.Entries {
+		if isCurrent[e.Code] {
+			// Codes are occasionally withdrawn and later reinstated, in
+			// which case the current entry takes precedence.
+			continue
+		}
+
+		fmt.Fprintf(
+			&buf,
+			"{Code: %q, NumericCode: %d, Name: %q, Withdrawn: date(%d, time.%s, %d)},\n",
+			e.Code,
+			e.NumericCode,
+			e.Name,
+			e.Withdrawn.Year(),
+			e.Withdrawn.Month(),
+			e.Withdrawn.Day(),
+		)
+	}
+
+	buf.WriteString("}\n")
+
+	return format.Source(buf.Bytes())
+}
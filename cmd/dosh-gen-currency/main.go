@@ -0,0 +1,65 @@
+// Command dosh-gen-currency generates the Go source for dosh's ISO 4217
+// currency table from the XML files published by the ISO 4217 maintenance
+// agency.
+//
+// Usage:
+//
+//	dosh-gen-currency -list-one <file> -list-three <file> [-out <file>]
+//
+// The "list one" file describes current currencies and funds, and the "list
+// three" file describes historic denominations. Both are available from
+// https://www.six-group.com/en/products-services/financial-information/data-standards.html.
+//
+// The generated source is written to stdout if -out is not specified.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+)
+
+func main() {
+	if err := run(os.Args[1:]); err != nil {
+		fmt.Fprintln(os.Stderr, "dosh-gen-currency:", err)
+		os.Exit(1)
+	}
+}
+
+// run executes the command with the given arguments.
+func run(args []string) error {
+	fs := flag.NewFlagSet("dosh-gen-currency", flag.ContinueOnError)
+	listOne := fs.String("list-one", "", "path to the ISO 4217 list one XML file (current currencies)")
+	listThree := fs.String("list-three", "", "path to the ISO 4217 list three XML file (historic denominations)")
+	out := fs.String("out", "", "path to the generated Go source file (default stdout)")
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if *listOne == "" || *listThree == "" {
+		return fmt.Errorf("both -list-one and -list-three must be specified")
+	}
+
+	current, err := readListOne(*listOne)
+	if err != nil {
+		return err
+	}
+
+	historic, err := readListThree(*listThree)
+	if err != nil {
+		return err
+	}
+
+	src, err := generate(current, historic)
+	if err != nil {
+		return err
+	}
+
+	if *out == "" {
+		_, err := os.Stdout.Write(src)
+		return err
+	}
+
+	return os.WriteFile(*out, src, 0644)
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("func run()", func() {
+	It("generates the Go source for the currency tables", func() {
+		dir, err := os.MkdirTemp("", "dosh-gen-currency-")
+		Expect(err).ShouldNot(HaveOccurred())
+		defer os.RemoveAll(dir)
+
+		out := filepath.Join(dir, "iso4217.go")
+
+		err = run([]string{
+			"-list-one", "testdata/list-one.xml",
+			"-list-three", "testdata/list-three.xml",
+			"-out", out,
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		actual, err := os.ReadFile(out)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		expect, err := os.ReadFile("testdata/iso4217.golden")
+		Expect(err).ShouldNot(HaveOccurred())
+
+		Expect(string(actual)).To(Equal(string(expect)))
+	})
+
+	DescribeTable(
+		"it returns an error if the arguments are invalid",
+		func(args []string, expect string) {
+			err := run(args)
+			Expect(err).To(MatchError(ContainSubstring(expect)))
+		},
+		Entry("missing list one", []string{"-list-three", "testdata/list-three.xml"}, "both -list-one and -list-three must be specified"),
+		Entry("missing list three", []string{"-list-one", "testdata/list-one.xml"}, "both -list-one and -list-three must be specified"),
+		Entry("non-existent file", []string{"-list-one", "testdata/missing.xml", "-list-three", "testdata/list-three.xml"}, "missing.xml"),
+		Entry("malformed XML", []string{"-list-one", "testdata/iso4217.golden", "-list-three", "testdata/list-three.xml"}, "iso4217.golden"),
+	)
+})
+
+var _ = Describe("func parseWithdrawn()", func() {
+	DescribeTable(
+		"it parses the withdrawal date",
+		func(s string, expect time.Time) {
+			t, err := parseWithdrawn(s)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(t).To(Equal(expect))
+		},
+		Entry("year and month", "2002-03", time.Date(2002, time.March, 1, 0, 0, 0, 0, time.UTC)),
+		Entry("range of years", "1989 to 1990", time.Date(1990, time.January, 1, 0, 0, 0, 0, time.UTC)),
+		Entry("single year", "1995", time.Date(1995, time.January, 1, 0, 0, 0, 0, time.UTC)),
+	)
+
+	DescribeTable(
+		"it returns an error if the date is invalid",
+		func(s, expect string) {
+			_, err := parseWithdrawn(s)
+			Expect(err).To(MatchError(expect))
+		},
+		Entry("invalid month", "2002-13", `"2002-13" is not a valid month`),
+		Entry("no year", "unknown", `"unknown" does not contain a year`),
+	)
+})
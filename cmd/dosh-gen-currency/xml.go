@@ -0,0 +1,181 @@
+package main
+
+import (
+	"encoding/xml"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// table is the parsed content of an ISO 4217 XML file.
+type table struct {
+	// Published is the date on which the table was published.
+	Published string
+
+	// Entries is the list of currencies in the table, sorted by code, with
+	// exactly one entry per code.
+	Entries []entry
+}
+
+// entry is a single currency within an ISO 4217 table.
+type entry struct {
+	Code        string
+	NumericCode int
+	MinorUnits  string // a number, or "N.A."
+	Name        string
+	IsFund      bool
+	Withdrawn   time.Time
+}
+
+// xmlEntry is the XML representation of an entry in either list one or list
+// three.
+type xmlEntry struct {
+	Name struct {
+		Value  string `xml:",chardata"`
+		IsFund bool   `xml:"IsFund,attr"`
+	} `xml:"CcyNm"`
+	Code        string `xml:"Ccy"`
+	NumericCode string `xml:"CcyNbr"`
+	MinorUnits  string `xml:"CcyMnrUnts"`
+	Withdrawn   string `xml:"WthdrwlDt"`
+}
+
+// readListOne reads an ISO 4217 "list one" XML file, which describes the
+// current currencies and funds.
+func readListOne(file string) (table, error) {
+	var doc struct {
+		Published string     `xml:"Pblshd,attr"`
+		Entries   []xmlEntry `xml:"CcyTbl>CcyNtry"`
+	}
+
+	if err := readXML(file, &doc); err != nil {
+		return table{}, err
+	}
+
+	return newTable(file, doc.Published, doc.Entries)
+}
+
+// readListThree reads an ISO 4217 "list three" XML file, which describes
+// historic denominations.
+func readListThree(file string) (table, error) {
+	var doc struct {
+		Published string     `xml:"Pblshd,attr"`
+		Entries   []xmlEntry `xml:"HstrcCcyTbl>HstrcCcyNtry"`
+	}
+
+	if err := readXML(file, &doc); err != nil {
+		return table{}, err
+	}
+
+	return newTable(file, doc.Published, doc.Entries)
+}
+
+// readXML unmarshals the XML content of a file into v.
+func readXML(file string, v any) error {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return err
+	}
+
+	if err := xml.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("%s: %w", file, err)
+	}
+
+	return nil
+}
+
+// newTable returns a table containing the given XML entries.
+//
+// ISO 4217 tables contain one entry per country, so the same currency may be
+// listed many times. Only one entry is kept for each currency code. If the
+// entries have differing withdrawal dates, the most recent is kept.
+func newTable(file, published string, entries []xmlEntry) (table, error) {
+	byCode := map[string]entry{}
+
+	for _, x := range entries {
+		code := strings.TrimSpace(x.Code)
+		if code == "" {
+			// Entries such as "ANTARCTICA" have no currency.
+			continue
+		}
+
+		e := entry{
+			Code:       code,
+			MinorUnits: strings.TrimSpace(x.MinorUnits),
+			Name:       strings.TrimSpace(x.Name.Value),
+			IsFund:     x.Name.IsFund,
+		}
+
+		if n := strings.TrimSpace(x.NumericCode); n != "" {
+			v, err := strconv.Atoi(n)
+			if err != nil {
+				return table{}, fmt.Errorf("%s: %s has an invalid numeric code (%s)", file, code, n)
+			}
+			e.NumericCode = v
+		}
+
+		if w := strings.TrimSpace(x.Withdrawn); w != "" {
+			t, err := parseWithdrawn(w)
+			if err != nil {
+				return table{}, fmt.Errorf("%s: %s has an invalid withdrawal date: %w", file, code, err)
+			}
+			e.Withdrawn = t
+		}
+
+		if prev, ok := byCode[code]; ok && !e.Withdrawn.After(prev.Withdrawn) {
+			continue
+		}
+
+		byCode[code] = e
+	}
+
+	t := table{Published: published}
+
+	for _, e := range byCode {
+		t.Entries = append(t.Entries, e)
+	}
+
+	sort.Slice(t.Entries, func(i, j int) bool {
+		return t.Entries[i].Code < t.Entries[j].Code
+	})
+
+	return t, nil
+}
+
+var (
+	// yearMonthPattern matches withdrawal dates of the form "2002-03".
+	yearMonthPattern = regexp.MustCompile(`^(\d{4})-(\d{2})$`)
+
+	// yearPattern matches a 4-digit year.
+	yearPattern = regexp.MustCompile(`\d{4}`)
+)
+
+// parseWithdrawn parses a withdrawal date from list three.
+//
+// Most dates are of the form "2002-03". Some older entries only specify a
+// range of years, such as "1989 to 1990", in which case the start of the last
+// year mentioned is used.
+func parseWithdrawn(s string) (time.Time, error) {
+	if m := yearMonthPattern.FindStringSubmatch(s); m != nil {
+		y, _ := strconv.Atoi(m[1])
+		mon, _ := strconv.Atoi(m[2])
+
+		if mon < 1 || mon > 12 {
+			return time.Time{}, fmt.Errorf("%q is not a valid month", s)
+		}
+
+		return time.Date(y, time.Month(mon), 1, 0, 0, 0, 0, time.UTC), nil
+	}
+
+	years := yearPattern.FindAllString(s, -1)
+	if len(years) == 0 {
+		return time.Time{}, fmt.Errorf("%q does not contain a year", s)
+	}
+
+	y, _ := strconv.Atoi(years[len(years)-1])
+	return time.Date(y, time.January, 1, 0, 0, 0, 0, time.UTC), nil
+}
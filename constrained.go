@@ -0,0 +1,304 @@
+package dosh
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/shopspring/decimal"
+)
+
+// Recipient describes a recipient's claim on an amount that is allocated by
+// Amount.AllocateConstrained().
+type Recipient struct {
+	// Weight is the recipient's share of the amount, relative to the other
+	// recipients. It must not be negative.
+	Weight decimal.Decimal
+
+	// Min, if non-nil, is the smallest amount the recipient may receive.
+	Min *Amount
+
+	// Max, if non-nil, is the largest amount the recipient may receive.
+	Max *Amount
+}
+
+// AllocateConstrained divides the amount between recipients in proportion to
+// their weights, subject to each recipient's minimum and maximum.
+//
+// The amount is allocated using "water-filling". Each recipient receives the
+// same multiple of their weight, except that recipients who would receive
+// less than their minimum receive exactly their minimum, and those who would
+// receive more than their maximum receive exactly their maximum. The surplus
+// from capped recipients is thereby redistributed to the others in proportion
+// to their weights. A recipient with a weight of zero receives their minimum.
+//
+// The exact allocation is then rounded to whole minor units using the largest
+// remainder method, as per Allocate(), such that the parts add up to exactly
+// the original amount and every constraint is still satisfied.
+//
+// It returns a descriptive error if the constraints can not be satisfied, such
+// as when the recipients' minimums add up to more than the amount. It also
+// returns an error if the amount is negative, if the currency has no minor
+// unit, or if the amount or any minimum or maximum is not a whole number of
+// minor units. It panics if no recipients are provided, or if any minimum or
+// maximum does not use the same currency as the amount.
+func (a Amount) AllocateConstrained(recipients ...Recipient) ([]Amount, error) {
+	if len(recipients) == 0 {
+		panic("at least one recipient must be provided")
+	}
+
+	parts, err := allocateConstrained(a, recipients)
+	if err != nil {
+		return nil, fmt.Errorf("cannot allocate %s: %w", describe(a), err)
+	}
+
+	return parts, nil
+}
+
+// bound is a constraint on the number of minor units received by a recipient.
+type bound struct {
+	lo, hi *big.Int // hi is nil if there is no maximum
+	w      *big.Int
+}
+
+func allocateConstrained(a Amount, recipients []Recipient) ([]Amount, error) {
+	total, places, err := toMinorUnits(a)
+	if err != nil {
+		return nil, err
+	}
+
+	if total.Sign() < 0 {
+		return nil, errors.New("amount is negative")
+	}
+
+	bounds, err := recipientBounds(a, recipients)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkFeasible(a, total, bounds, places); err != nil {
+		return nil, err
+	}
+
+	shares := waterFill(total, bounds)
+
+	parts := make([]*big.Int, len(shares))
+	rems := make([]*big.Rat, len(shares))
+	left := new(big.Int).Set(total)
+
+	for i, x := range shares {
+		parts[i] = new(big.Int).Quo(x.Num(), x.Denom())
+		rems[i] = new(big.Rat).Sub(x, new(big.Rat).SetInt(parts[i]))
+		left.Sub(left, parts[i])
+	}
+
+	// Each share is within its bounds, which are whole numbers of minor
+	// units, so any share with a fractional part can be rounded up without
+	// exceeding its maximum.
+	distributeLeftover(parts, left, func(i, j int) bool {
+		return rems[i].Cmp(rems[j]) > 0
+	})
+
+	return fromMinorUnits(a, parts, places, false), nil
+}
+
+// recipientBounds returns the constraints described by the recipients, in
+// minor units.
+func recipientBounds(a Amount, recipients []Recipient) ([]bound, error) {
+	weights := make([]decimal.Decimal, len(recipients))
+	for i, r := range recipients {
+		if r.Weight.IsNegative() {
+			return nil, fmt.Errorf("recipient %d has a negative weight (%s)", i, r.Weight)
+		}
+		weights[i] = r.Weight
+	}
+
+	bounds := make([]bound, len(recipients))
+	for i, w := range scaleToIntegers(weights) {
+		b := bound{lo: new(big.Int), w: w}
+		r := recipients[i]
+
+		if r.Min != nil {
+			lo, err := constraintUnits(a, *r.Min)
+			if err != nil {
+				return nil, fmt.Errorf("minimum for recipient %d (%s) %w", i, r.Min.String(), err)
+			}
+			b.lo = lo
+		}
+
+		if r.Max != nil {
+			hi, err := constraintUnits(a, *r.Max)
+			if err != nil {
+				return nil, fmt.Errorf("maximum for recipient %d (%s) %w", i, r.Max.String(), err)
+			}
+			b.hi = hi
+		}
+
+		if b.hi != nil && b.lo.Cmp(b.hi) > 0 {
+			return nil, fmt.Errorf(
+				"minimum for recipient %d (%s) exceeds its maximum (%s)",
+				i,
+				r.Min.String(),
+				r.Max.String(),
+			)
+		}
+
+		bounds[i] = b
+	}
+
+	return bounds, nil
+}
+
+// constraintUnits returns the number of minor units in c, which is a minimum
+// or maximum for an allocation of a.
+func constraintUnits(a, c Amount) (*big.Int, error) {
+	assertSameCurrency(a, c)
+
+	if c.IsNegative() {
+		return nil, errors.New("is negative")
+	}
+
+	units, _, err := toMinorUnits(c)
+	if err != nil {
+		return nil, errors.New("is not a whole number of minor units")
+	}
+
+	return units, nil
+}
+
+// checkFeasible returns an error if total can not be allocated within the
+// given bounds.
+func checkFeasible(a Amount, total *big.Int, bounds []bound, places int32) error {
+	lo := new(big.Int)
+	hi := new(big.Int)
+	unbounded := false
+
+	for _, b := range bounds {
+		lo.Add(lo, b.lo)
+
+		switch {
+		case b.w.Sign() == 0:
+			// A recipient with no weight only ever receives its minimum, which
+			// is therefore its effective maximum.
+			hi.Add(hi, b.lo)
+		case b.hi == nil:
+			unbounded = true
+		default:
+			hi.Add(hi, b.hi)
+		}
+	}
+
+	format := func(units *big.Int) Amount {
+		return Amount{cur: a.cur, mag: decimal.NewFromBigInt(units, -places)}
+	}
+
+	if lo.Cmp(total) > 0 {
+		return fmt.Errorf(
+			"the sum of the minimums (%s) exceeds the amount (%s)",
+			format(lo).String(),
+			a.String(),
+		)
+	}
+
+	if !unbounded && hi.Cmp(total) < 0 {
+		return fmt.Errorf(
+			"the amount (%s) exceeds the sum of the maximums (%s)",
+			a.String(),
+			format(hi).String(),
+		)
+	}
+
+	return nil
+}
+
+// waterFill returns the exact share of total received by each recipient.
+//
+// Each share is clamp(λ × w, lo, hi) for the unique level λ at which the shares
+// add up to total. The constraints must be feasible.
+func waterFill(total *big.Int, bounds []bound) []*big.Rat {
+	// The sum of the shares is a non-decreasing, piecewise linear function of
+	// λ, with breakpoints where a recipient reaches its minimum or maximum.
+	levels := []*big.Rat{new(big.Rat)}
+	for _, b := range bounds {
+		if b.w.Sign() == 0 {
+			continue
+		}
+
+		levels = append(levels, new(big.Rat).SetFrac(b.lo, b.w))
+		if b.hi != nil {
+			levels = append(levels, new(big.Rat).SetFrac(b.hi, b.w))
+		}
+	}
+
+	sort.Slice(levels, func(i, j int) bool {
+		return levels[i].Cmp(levels[j]) < 0
+	})
+
+	t := new(big.Rat).SetInt(total)
+
+	// Find the last breakpoint at which the sum does not exceed the total. The
+	// sum at the first breakpoint (λ = 0) is the sum of the minimums, which is
+	// known not to exceed the total.
+	k := sort.Search(len(levels), func(i int) bool {
+		return sumShares(levels[i], bounds).Cmp(t) > 0
+	}) - 1
+
+	level := levels[k]
+	sum := sumShares(level, bounds)
+
+	if sum.Cmp(t) != 0 {
+		// Between this breakpoint and the next the sum increases in proportion
+		// to the weights of the recipients that are not clamped.
+		slope := new(big.Int)
+		for _, b := range bounds {
+			if b.w.Sign() == 0 {
+				continue
+			}
+
+			lw := new(big.Rat).Mul(level, new(big.Rat).SetInt(b.w))
+			if lw.Cmp(new(big.Rat).SetInt(b.lo)) >= 0 &&
+				(b.hi == nil || lw.Cmp(new(big.Rat).SetInt(b.hi)) < 0) {
+				slope.Add(slope, b.w)
+			}
+		}
+
+		delta := new(big.Rat).Sub(t, sum)
+		delta.Quo(delta, new(big.Rat).SetInt(slope))
+		level = new(big.Rat).Add(level, delta)
+	}
+
+	shares := make([]*big.Rat, len(bounds))
+	for i, b := range bounds {
+		shares[i] = share(level, b)
+	}
+
+	return shares
+}
+
+// sumShares returns the sum of the shares received by each recipient at the
+// given level.
+func sumShares(level *big.Rat, bounds []bound) *big.Rat {
+	sum := new(big.Rat)
+	for _, b := range bounds {
+		sum.Add(sum, share(level, b))
+	}
+	return sum
+}
+
+// share returns clamp(level × w, lo, hi).
+func share(level *big.Rat, b bound) *big.Rat {
+	x := new(big.Rat).Mul(level, new(big.Rat).SetInt(b.w))
+
+	if lo := new(big.Rat).SetInt(b.lo); x.Cmp(lo) < 0 {
+		return lo
+	}
+
+	if b.hi != nil {
+		if hi := new(big.Rat).SetInt(b.hi); x.Cmp(hi) > 0 {
+			return hi
+		}
+	}
+
+	return x
+}
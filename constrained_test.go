@@ -0,0 +1,213 @@
+package dosh_test
+
+import (
+	. "github.com/dogmatiq/dosh"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/shopspring/decimal"
+)
+
+// usd returns a pointer to a USD amount, for use as a constraint.
+func usd(m string) *Amount {
+	a := FromString("USD", m)
+	return &a
+}
+
+// weight returns a recipient with the given weight and no constraints.
+func weight(w string) Recipient {
+	return Recipient{Weight: decimal.RequireFromString(w)}
+}
+
+var _ = Describe("type Amount (constrained allocation methods)", func() {
+	Describe("func AllocateConstrained()", func() {
+		DescribeTable(
+			"it allocates the amount in proportion to the weights, within the constraints",
+			func(a Amount, recipients []Recipient, expect []string) {
+				parts, err := a.AllocateConstrained(recipients...)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(magnitudes(parts)).To(Equal(expect))
+				Expect(Sum(parts...).EqualTo(a)).To(BeTrue())
+
+				for i, r := range recipients {
+					if r.Min != nil {
+						Expect(parts[i].GreaterThanOrEqualTo(*r.Min)).To(BeTrue())
+					}
+					if r.Max != nil {
+						Expect(parts[i].LessThanOrEqualTo(*r.Max)).To(BeTrue())
+					}
+				}
+			},
+			Entry(
+				"no constraints",
+				FromInt("USD", 100),
+				[]Recipient{weight("1"), weight("1"), weight("1")},
+				[]string{"33.34", "33.33", "33.33"},
+			),
+			Entry(
+				"capped surplus is redistributed",
+				FromInt("USD", 100),
+				[]Recipient{
+					{Weight: decimal.NewFromInt(2), Max: usd("20")},
+					weight("1"),
+					weight("1"),
+				},
+				[]string{"20", "40", "40"},
+			),
+			Entry(
+				"capped surplus is redistributed in proportion to the weights",
+				FromInt("USD", 100),
+				[]Recipient{
+					{Weight: decimal.NewFromInt(5), Max: usd("10")},
+					weight("3"),
+					weight("1"),
+				},
+				[]string{"10", "67.5", "22.5"},
+			),
+			Entry(
+				"minimums are raised at the expense of the others",
+				FromInt("USD", 100),
+				[]Recipient{
+					{Weight: decimal.NewFromInt(1), Min: usd("50")},
+					weight("1"),
+					weight("2"),
+				},
+				[]string{"50", "16.67", "33.33"},
+			),
+			Entry(
+				"cascading caps",
+				FromInt("USD", 100),
+				[]Recipient{
+					{Weight: decimal.NewFromInt(1), Max: usd("10")},
+					{Weight: decimal.NewFromInt(1), Max: usd("30")},
+					{Weight: decimal.NewFromInt(1), Max: usd("70")},
+				},
+				[]string{"10", "30", "60"},
+			),
+			Entry(
+				"all recipients capped exactly",
+				FromInt("USD", 100),
+				[]Recipient{
+					{Weight: decimal.NewFromInt(1), Max: usd("40")},
+					{Weight: decimal.NewFromInt(1), Max: usd("60")},
+				},
+				[]string{"40", "60"},
+			),
+			Entry(
+				"zero weight receives its minimum",
+				FromInt("USD", 100),
+				[]Recipient{
+					{Weight: decimal.Zero, Min: usd("5")},
+					weight("1"),
+				},
+				[]string{"5", "95"},
+			),
+			Entry(
+				"zero weight without a minimum receives nothing",
+				FromInt("USD", 100),
+				[]Recipient{
+					weight("0"),
+					weight("1"),
+				},
+				[]string{"0", "100"},
+			),
+			Entry(
+				"rounding respects the constraints",
+				FromInt("USD", 1),
+				[]Recipient{
+					{Weight: decimal.NewFromInt(1), Max: usd("0.33")},
+					weight("1"),
+					weight("1"),
+				},
+				[]string{"0.33", "0.34", "0.33"},
+			),
+			Entry(
+				"zero amount",
+				Zero("USD"),
+				[]Recipient{weight("1"), weight("1")},
+				[]string{"0", "0"},
+			),
+		)
+
+		DescribeTable(
+			"it returns an error if the constraints can not be satisfied",
+			func(a Amount, recipients []Recipient, expect string) {
+				_, err := a.AllocateConstrained(recipients...)
+				Expect(err).To(MatchError(expect))
+			},
+			Entry(
+				"minimums exceed the amount",
+				FromInt("USD", 100),
+				[]Recipient{
+					{Weight: decimal.NewFromInt(1), Min: usd("60")},
+					{Weight: decimal.NewFromInt(1), Min: usd("50")},
+				},
+				"cannot allocate USD amount: the sum of the minimums (USD 110) exceeds the amount (USD 100)",
+			),
+			Entry(
+				"amount exceeds the maximums",
+				FromInt("USD", 100),
+				[]Recipient{
+					{Weight: decimal.NewFromInt(1), Max: usd("30")},
+					{Weight: decimal.NewFromInt(1), Max: usd("50")},
+				},
+				"cannot allocate USD amount: the amount (USD 100) exceeds the sum of the maximums (USD 80)",
+			),
+			Entry(
+				"all weights are zero",
+				FromInt("USD", 100),
+				[]Recipient{weight("0"), weight("0")},
+				"cannot allocate USD amount: the amount (USD 100) exceeds the sum of the maximums (USD 0)",
+			),
+			Entry(
+				"minimum exceeds maximum",
+				FromInt("USD", 100),
+				[]Recipient{
+					weight("1"),
+					{Weight: decimal.NewFromInt(1), Min: usd("50"), Max: usd("40")},
+				},
+				"cannot allocate USD amount: minimum for recipient 1 (USD 50) exceeds its maximum (USD 40)",
+			),
+			Entry(
+				"negative minimum",
+				FromInt("USD", 100),
+				[]Recipient{{Weight: decimal.NewFromInt(1), Min: usd("-1")}},
+				"cannot allocate USD amount: minimum for recipient 0 (USD -1) is negative",
+			),
+			Entry(
+				"maximum is not a whole number of minor units",
+				FromInt("USD", 100),
+				[]Recipient{{Weight: decimal.NewFromInt(1), Max: usd("0.001")}},
+				"cannot allocate USD amount: maximum for recipient 0 (USD 0.001) is not a whole number of minor units",
+			),
+			Entry(
+				"negative weight",
+				FromInt("USD", 100),
+				[]Recipient{weight("-1")},
+				"cannot allocate USD amount: recipient 0 has a negative weight (-1)",
+			),
+			Entry(
+				"negative amount",
+				FromInt("USD", -100),
+				[]Recipient{weight("1")},
+				"cannot allocate USD amount: amount is negative",
+			),
+		)
+
+		It("panics if no recipients are provided", func() {
+			Expect(func() {
+				FromInt("USD", 100).AllocateConstrained()
+			}).To(PanicWith("at least one recipient must be provided"))
+		})
+
+		It("panics if a constraint does not use the same currency", func() {
+			max := Unit("EUR")
+
+			Expect(func() {
+				FromInt("USD", 100).AllocateConstrained(
+					Recipient{Weight: decimal.NewFromInt(1), Max: &max},
+				)
+			}).To(PanicWith("can not operate on amounts in differing currencies (USD vs EUR)"))
+		})
+	})
+})
@@ -0,0 +1,103 @@
+package dosh
+
+import (
+	"github.com/dogmatiq/dosh/currency"
+)
+
+// Currency identifies the currency in which an Amount is expressed.
+//
+// Unlike a bare currency code, a Currency provides access to the currency's
+// metadata, such as its name and the precision of its minor unit.
+//
+// The zero-value is not a valid currency.
+type Currency struct {
+	code string
+}
+
+// NewCurrency returns the currency with the given code.
+//
+// It panics if c is not valid under the current currency policy, as set by
+// currency.SetPolicy().
+func NewCurrency(c string) Currency {
+	if err := currency.ValidateCode(c); err != nil {
+		panic(err)
+	}
+
+	return Currency{c}
+}
+
+// Code returns the currency's code, such as "USD".
+func (c Currency) Code() string {
+	return c.code
+}
+
+// NumericCode returns the currency's ISO 4217 numeric code, such as 840 for
+// "USD".
+//
+// It returns zero if the currency does not have a numeric code.
+func (c Currency) NumericCode() int {
+	i, _ := c.Info()
+	return i.NumericCode
+}
+
+// MinorUnits returns the number of decimal places used by the currency's minor
+// unit, such as 2 for "USD".
+//
+// ok is false if the currency is not known, or if the concept of a minor unit
+// is not applicable to the currency.
+func (c Currency) MinorUnits() (n int, ok bool) {
+	i, ok := c.Info()
+	if !ok || !i.HasMinorUnits() {
+		return 0, false
+	}
+
+	return i.MinorUnits, true
+}
+
+// Symbol returns the symbol used to represent the currency, such as "$" for
+// "USD".
+//
+// It returns an empty string if the currency does not have a known symbol.
+func (c Currency) Symbol() string {
+	i, _ := c.Info()
+	return i.Symbol
+}
+
+// UnitOfMeasure returns the unit in which amounts of the currency are
+// measured, if it is a physical commodity.
+//
+// It returns "troy ounce" for precious metals such as "XAU" (gold), and an
+// empty string for all other currencies.
+func (c Currency) UnitOfMeasure() string {
+	i, _ := c.Info()
+	return i.UnitOfMeasure()
+}
+
+// Name returns the English name of the currency, such as "US Dollar" for
+// "USD".
+//
+// It returns an empty string if the currency is not known.
+func (c Currency) Name() string {
+	i, _ := c.Info()
+	return i.Name
+}
+
+// Info returns the currency's metadata.
+//
+// ok is false if the currency is not known. Currencies that are not defined by
+// ISO 4217 or registered with currency.Register() are not known, but may still
+// be valid under the current currency policy.
+func (c Currency) Info() (_ currency.Info, ok bool) {
+	return currency.Lookup(c.code)
+}
+
+// Validate returns an error if c is not valid under the current currency
+// policy.
+func (c Currency) Validate() error {
+	return currency.ValidateCode(c.code)
+}
+
+// String returns the currency's code.
+func (c Currency) String() string {
+	return c.code
+}
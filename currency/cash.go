@@ -0,0 +1,74 @@
+package currency
+
+import "github.com/shopspring/decimal"
+
+// cashRounding describes the smallest increment in which cash payments in a
+// currency are settled within a country or territory.
+type cashRounding struct {
+	// Country is the ISO 3166-1 alpha-2 code of the country or territory.
+	Country string
+
+	// Currency is the code of the currency.
+	Currency string
+
+	// Increment is the smallest amount in which cash payments are settled.
+	Increment string
+}
+
+// cashRoundings is the list of countries and territories in which cash
+// payments are rounded to an increment larger than the currency's minor unit,
+// typically because the smallest coins have been withdrawn from circulation.
+//
+// Non-cash payments, such as card payments, are not rounded in these
+// countries.
+var cashRoundings = []cashRounding{
+	{Country: "AU", Currency: "AUD", Increment: "0.05"},
+	{Country: "BE", Currency: "EUR", Increment: "0.05"},
+	{Country: "CA", Currency: "CAD", Increment: "0.05"},
+	{Country: "CH", Currency: "CHF", Increment: "0.05"},
+	{Country: "CZ", Currency: "CZK", Increment: "1"},
+	{Country: "DK", Currency: "DKK", Increment: "0.50"},
+	{Country: "FI", Currency: "EUR", Increment: "0.05"},
+	{Country: "FO", Currency: "DKK", Increment: "0.50"},
+	{Country: "GL", Currency: "DKK", Increment: "0.50"},
+	{Country: "HU", Currency: "HUF", Increment: "5"},
+	{Country: "IE", Currency: "EUR", Increment: "0.05"},
+	{Country: "IT", Currency: "EUR", Increment: "0.05"},
+	{Country: "LI", Currency: "CHF", Increment: "0.05"},
+	{Country: "NL", Currency: "EUR", Increment: "0.05"},
+	{Country: "NO", Currency: "NOK", Increment: "1"},
+	{Country: "NZ", Currency: "NZD", Increment: "0.10"},
+	{Country: "SE", Currency: "SEK", Increment: "1"},
+	{Country: "SK", Currency: "EUR", Increment: "0.05"},
+}
+
+// cashIncrements is an index of cash rounding increments, keyed by country
+// code, then currency code.
+var cashIncrements = map[string]map[string]decimal.Decimal{}
+
+func init() {
+	for _, x := range cashRoundings {
+		m := cashIncrements[x.Country]
+		if m == nil {
+			m = map[string]decimal.Decimal{}
+			cashIncrements[x.Country] = m
+		}
+
+		m[x.Currency] = decimal.RequireFromString(x.Increment)
+	}
+}
+
+// CashIncrement returns the smallest increment in which cash payments in the
+// currency c are settled within a country or territory.
+//
+// country is an ISO 3166-1 alpha-2 code, such as "CH". For example, cash
+// payments in Swiss francs ("CHF") are rounded to the nearest 0.05 in
+// Switzerland.
+//
+// ok is false if cash payments in c are not rounded to an increment larger
+// than the currency's minor unit within the country, or if either code is not
+// known.
+func CashIncrement(country, c string) (_ decimal.Decimal, ok bool) {
+	inc, ok := cashIncrements[country][c]
+	return inc, ok
+}
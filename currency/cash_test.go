@@ -0,0 +1,37 @@
+package currency_test
+
+import (
+	. "github.com/dogmatiq/dosh/currency"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/shopspring/decimal"
+)
+
+var _ = Describe("func CashIncrement()", func() {
+	DescribeTable(
+		"it returns the cash rounding increment",
+		func(country, c, expect string) {
+			inc, ok := CashIncrement(country, c)
+			Expect(ok).To(BeTrue())
+			Expect(inc.Equal(decimal.RequireFromString(expect))).To(BeTrue())
+		},
+		Entry("Swiss franc in Switzerland", "CH", "CHF", "0.05"),
+		Entry("Swiss franc in Liechtenstein", "LI", "CHF", "0.05"),
+		Entry("Swedish krona in Sweden", "SE", "SEK", "1"),
+		Entry("Canadian dollar in Canada", "CA", "CAD", "0.05"),
+		Entry("Danish krone in Denmark", "DK", "DKK", "0.50"),
+		Entry("Hungarian forint in Hungary", "HU", "HUF", "5"),
+	)
+
+	DescribeTable(
+		"it returns false if cash payments are not rounded",
+		func(country, c string) {
+			_, ok := CashIncrement(country, c)
+			Expect(ok).To(BeFalse())
+		},
+		Entry("no cash rounding in the country", "US", "USD"),
+		Entry("currency is not used for cash rounding in the country", "CH", "EUR"),
+		Entry("unknown country", "ZZ", "CHF"),
+	)
+})
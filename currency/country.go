@@ -0,0 +1,402 @@
+package currency
+
+import (
+	"sort"
+	"time"
+)
+
+// tender describes the use of a currency as legal tender within a country or
+// territory.
+type tender struct {
+	// Country is the ISO 3166-1 alpha-2 code of the country or territory.
+	Country string
+
+	// Currency is the code of the currency.
+	Currency string
+
+	// From is the date on which the currency became legal tender. It is the
+	// zero-value if the currency has been legal tender for as long as is
+	// relevant to this table.
+	From time.Time
+
+	// Until is the date on which the currency ceased to be legal tender. It is
+	// the zero-value if the currency is still legal tender.
+	Until time.Time
+}
+
+// isLegalTenderOn returns true if the currency was legal tender at time t.
+func (x tender) isLegalTenderOn(t time.Time) bool {
+	if !x.From.IsZero() && t.Before(x.From) {
+		return false
+	}
+
+	if !x.Until.IsZero() && !t.Before(x.Until) {
+		return false
+	}
+
+	return true
+}
+
+// tenders is the list of currencies that are, or have been, legal tender in
+// each country or territory.
+//
+// The currencies for each country are listed in order of preference, such
+// that the first currency that is legal tender at a given time is the one
+// most likely to be expected by a customer in that country. Funds, such as
+// "USN", are not included.
+var tenders = []tender{
+	{Country: "AD", Currency: "EUR"},
+	{Country: "AE", Currency: "AED"},
+	{Country: "AF", Currency: "AFN"},
+	{Country: "AG", Currency: "XCD"},
+	{Country: "AI", Currency: "XCD"},
+	{Country: "AL", Currency: "ALL"},
+	{Country: "AM", Currency: "AMD"},
+	{Country: "AO", Currency: "AOA"},
+	{Country: "AR", Currency: "ARS"},
+	{Country: "AS", Currency: "USD"},
+	{Country: "AT", Currency: "EUR", From: date(1999, time.January, 1)},
+	{Country: "AT", Currency: "ATS", Until: date(2002, time.March, 1)},
+	{Country: "AU", Currency: "AUD"},
+	{Country: "AW", Currency: "AWG"},
+	{Country: "AX", Currency: "EUR"},
+	{Country: "AZ", Currency: "AZN", From: date(2006, time.January, 1)},
+	{Country: "AZ", Currency: "AZM", Until: date(2006, time.January, 1)},
+	{Country: "BA", Currency: "BAM"},
+	{Country: "BB", Currency: "BBD"},
+	{Country: "BD", Currency: "BDT"},
+	{Country: "BE", Currency: "EUR", From: date(1999, time.January, 1)},
+	{Country: "BE", Currency: "BEF", Until: date(2002, time.March, 1)},
+	{Country: "BF", Currency: "XOF"},
+	{Country: "BG", Currency: "EUR", From: date(2026, time.January, 1)},
+	{Country: "BG", Currency: "BGN", From: date(1999, time.July, 5), Until: date(2026, time.February, 1)},
+	{Country: "BG", Currency: "BGL", Until: date(2003, time.November, 1)},
+	{Country: "BH", Currency: "BHD"},
+	{Country: "BI", Currency: "BIF"},
+	{Country: "BJ", Currency: "XOF"},
+	{Country: "BL", Currency: "EUR"},
+	{Country: "BM", Currency: "BMD"},
+	{Country: "BN", Currency: "BND"},
+	{Country: "BO", Currency: "BOB"},
+	{Country: "BQ", Currency: "USD"},
+	{Country: "BR", Currency: "BRL"},
+	{Country: "BS", Currency: "BSD"},
+	{Country: "BT", Currency: "BTN"},
+	{Country: "BT", Currency: "INR"},
+	{Country: "BV", Currency: "NOK"},
+	{Country: "BW", Currency: "BWP"},
+	{Country: "BY", Currency: "BYN", From: date(2016, time.July, 1)},
+	{Country: "BY", Currency: "BYR", Until: date(2017, time.January, 1)},
+	{Country: "BZ", Currency: "BZD"},
+	{Country: "CA", Currency: "CAD"},
+	{Country: "CC", Currency: "AUD"},
+	{Country: "CD", Currency: "CDF"},
+	{Country: "CF", Currency: "XAF"},
+	{Country: "CG", Currency: "XAF"},
+	{Country: "CH", Currency: "CHF"},
+	{Country: "CI", Currency: "XOF"},
+	{Country: "CK", Currency: "NZD"},
+	{Country: "CL", Currency: "CLP"},
+	{Country: "CM", Currency: "XAF"},
+	{Country: "CN", Currency: "CNY"},
+	{Country: "CO", Currency: "COP"},
+	{Country: "CR", Currency: "CRC"},
+	{Country: "CU", Currency: "CUP"},
+	{Country: "CU", Currency: "CUC", Until: date(2021, time.January, 1)},
+	{Country: "CV", Currency: "CVE"},
+	{Country: "CW", Currency: "XCG", From: date(2025, time.March, 31)},
+	{Country: "CW", Currency: "ANG", Until: date(2025, time.April, 1)},
+	{Country: "CX", Currency: "AUD"},
+	{Country: "CY", Currency: "EUR", From: date(2008, time.January, 1)},
+	{Country: "CY", Currency: "CYP", Until: date(2008, time.January, 1)},
+	{Country: "CZ", Currency: "CZK"},
+	{Country: "DE", Currency: "EUR", From: date(1999, time.January, 1)},
+	{Country: "DE", Currency: "DEM", Until: date(2002, time.March, 1)},
+	{Country: "DJ", Currency: "DJF"},
+	{Country: "DK", Currency: "DKK"},
+	{Country: "DM", Currency: "XCD"},
+	{Country: "DO", Currency: "DOP"},
+	{Country: "DZ", Currency: "DZD"},
+	{Country: "EC", Currency: "USD"},
+	{Country: "EE", Currency: "EUR", From: date(2011, time.January, 1)},
+	{Country: "EE", Currency: "EEK", Until: date(2011, time.January, 1)},
+	{Country: "EG", Currency: "EGP"},
+	{Country: "EH", Currency: "MAD"},
+	{Country: "ER", Currency: "ERN"},
+	{Country: "ES", Currency: "EUR", From: date(1999, time.January, 1)},
+	{Country: "ES", Currency: "ESP", Until: date(2002, time.March, 1)},
+	{Country: "ET", Currency: "ETB"},
+	{Country: "FI", Currency: "EUR", From: date(1999, time.January, 1)},
+	{Country: "FI", Currency: "FIM", Until: date(2002, time.March, 1)},
+	{Country: "FJ", Currency: "FJD"},
+	{Country: "FK", Currency: "FKP"},
+	{Country: "FM", Currency: "USD"},
+	{Country: "FO", Currency: "DKK"},
+	{Country: "FR", Currency: "EUR", From: date(1999, time.January, 1)},
+	{Country: "FR", Currency: "FRF", Until: date(2002, time.March, 1)},
+	{Country: "GA", Currency: "XAF"},
+	{Country: "GB", Currency: "GBP"},
+	{Country: "GD", Currency: "XCD"},
+	{Country: "GE", Currency: "GEL"},
+	{Country: "GF", Currency: "EUR"},
+	{Country: "GG", Currency: "GBP"},
+	{Country: "GH", Currency: "GHS", From: date(2007, time.July, 1)},
+	{Country: "GH", Currency: "GHC", Until: date(2008, time.January, 1)},
+	{Country: "GI", Currency: "GIP"},
+	{Country: "GL", Currency: "DKK"},
+	{Country: "GM", Currency: "GMD"},
+	{Country: "GN", Currency: "GNF"},
+	{Country: "GP", Currency: "EUR"},
+	{Country: "GQ", Currency: "XAF"},
+	{Country: "GR", Currency: "EUR", From: date(2001, time.January, 1)},
+	{Country: "GR", Currency: "GRD", Until: date(2002, time.March, 1)},
+	{Country: "GS", Currency: "GBP"},
+	{Country: "GT", Currency: "GTQ"},
+	{Country: "GU", Currency: "USD"},
+	{Country: "GW", Currency: "XOF"},
+	{Country: "GY", Currency: "GYD"},
+	{Country: "HK", Currency: "HKD"},
+	{Country: "HM", Currency: "AUD"},
+	{Country: "HN", Currency: "HNL"},
+	{Country: "HR", Currency: "EUR", From: date(2023, time.January, 1)},
+	{Country: "HR", Currency: "HRK", Until: date(2023, time.January, 15)},
+	{Country: "HT", Currency: "HTG"},
+	{Country: "HT", Currency: "USD"},
+	{Country: "HU", Currency: "HUF"},
+	{Country: "ID", Currency: "IDR"},
+	{Country: "IE", Currency: "EUR", From: date(1999, time.January, 1)},
+	{Country: "IE", Currency: "IEP", Until: date(2002, time.March, 1)},
+	{Country: "IL", Currency: "ILS"},
+	{Country: "IM", Currency: "GBP"},
+	{Country: "IN", Currency: "INR"},
+	{Country: "IO", Currency: "USD"},
+	{Country: "IQ", Currency: "IQD"},
+	{Country: "IR", Currency: "IRR"},
+	{Country: "IS", Currency: "ISK"},
+	{Country: "IT", Currency: "EUR", From: date(1999, time.January, 1)},
+	{Country: "IT", Currency: "ITL", Until: date(2002, time.March, 1)},
+	{Country: "JE", Currency: "GBP"},
+	{Country: "JM", Currency: "JMD"},
+	{Country: "JO", Currency: "JOD"},
+	{Country: "JP", Currency: "JPY"},
+	{Country: "KE", Currency: "KES"},
+	{Country: "KG", Currency: "KGS"},
+	{Country: "KH", Currency: "KHR"},
+	{Country: "KI", Currency: "AUD"},
+	{Country: "KM", Currency: "KMF"},
+	{Country: "KN", Currency: "XCD"},
+	{Country: "KP", Currency: "KPW"},
+	{Country: "KR", Currency: "KRW"},
+	{Country: "KW", Currency: "KWD"},
+	{Country: "KY", Currency: "KYD"},
+	{Country: "KZ", Currency: "KZT"},
+	{Country: "LA", Currency: "LAK"},
+	{Country: "LB", Currency: "LBP"},
+	{Country: "LC", Currency: "XCD"},
+	{Country: "LI", Currency: "CHF"},
+	{Country: "LK", Currency: "LKR"},
+	{Country: "LR", Currency: "LRD"},
+	{Country: "LS", Currency: "LSL"},
+	{Country: "LS", Currency: "ZAR"},
+	{Country: "LT", Currency: "EUR", From: date(2015, time.January, 1)},
+	{Country: "LT", Currency: "LTL", Until: date(2015, time.January, 1)},
+	{Country: "LU", Currency: "EUR", From: date(1999, time.January, 1)},
+	{Country: "LU", Currency: "LUF", Until: date(2002, time.March, 1)},
+	{Country: "LV", Currency: "EUR", From: date(2014, time.January, 1)},
+	{Country: "LV", Currency: "LVL", Until: date(2014, time.January, 1)},
+	{Country: "LY", Currency: "LYD"},
+	{Country: "MA", Currency: "MAD"},
+	{Country: "MC", Currency: "EUR"},
+	{Country: "MD", Currency: "MDL"},
+	{Country: "ME", Currency: "EUR"},
+	{Country: "MF", Currency: "EUR"},
+	{Country: "MG", Currency: "MGA", From: date(2003, time.July, 31)},
+	{Country: "MG", Currency: "MGF", Until: date(2005, time.January, 1)},
+	{Country: "MH", Currency: "USD"},
+	{Country: "MK", Currency: "MKD"},
+	{Country: "ML", Currency: "XOF"},
+	{Country: "MM", Currency: "MMK"},
+	{Country: "MN", Currency: "MNT"},
+	{Country: "MO", Currency: "MOP"},
+	{Country: "MP", Currency: "USD"},
+	{Country: "MQ", Currency: "EUR"},
+	{Country: "MR", Currency: "MRU", From: date(2018, time.January, 1)},
+	{Country: "MR", Currency: "MRO", Until: date(2018, time.January, 1)},
+	{Country: "MS", Currency: "XCD"},
+	{Country: "MT", Currency: "EUR", From: date(2008, time.January, 1)},
+	{Country: "MT", Currency: "MTL", Until: date(2008, time.January, 1)},
+	{Country: "MU", Currency: "MUR"},
+	{Country: "MV", Currency: "MVR"},
+	{Country: "MW", Currency: "MWK"},
+	{Country: "MX", Currency: "MXN"},
+	{Country: "MY", Currency: "MYR"},
+	{Country: "MZ", Currency: "MZN", From: date(2006, time.July, 1)},
+	{Country: "MZ", Currency: "MZM", Until: date(2007, time.January, 1)},
+	{Country: "NA", Currency: "NAD"},
+	{Country: "NA", Currency: "ZAR"},
+	{Country: "NC", Currency: "XPF"},
+	{Country: "NE", Currency: "XOF"},
+	{Country: "NF", Currency: "AUD"},
+	{Country: "NG", Currency: "NGN"},
+	{Country: "NI", Currency: "NIO"},
+	{Country: "NL", Currency: "EUR", From: date(1999, time.January, 1)},
+	{Country: "NL", Currency: "NLG", Until: date(2002, time.March, 1)},
+	{Country: "NO", Currency: "NOK"},
+	{Country: "NP", Currency: "NPR"},
+	{Country: "NR", Currency: "AUD"},
+	{Country: "NU", Currency: "NZD"},
+	{Country: "NZ", Currency: "NZD"},
+	{Country: "OM", Currency: "OMR"},
+	{Country: "PA", Currency: "PAB"},
+	{Country: "PA", Currency: "USD"},
+	{Country: "PE", Currency: "PEN"},
+	{Country: "PF", Currency: "XPF"},
+	{Country: "PG", Currency: "PGK"},
+	{Country: "PH", Currency: "PHP"},
+	{Country: "PK", Currency: "PKR"},
+	{Country: "PL", Currency: "PLN", From: date(1995, time.January, 1)},
+	{Country: "PL", Currency: "PLZ", Until: date(1997, time.January, 1)},
+	{Country: "PM", Currency: "EUR"},
+	{Country: "PN", Currency: "NZD"},
+	{Country: "PR", Currency: "USD"},
+	{Country: "PS", Currency: "ILS"},
+	{Country: "PS", Currency: "JOD"},
+	{Country: "PT", Currency: "EUR", From: date(1999, time.January, 1)},
+	{Country: "PT", Currency: "PTE", Until: date(2002, time.March, 1)},
+	{Country: "PW", Currency: "USD"},
+	{Country: "PY", Currency: "PYG"},
+	{Country: "QA", Currency: "QAR"},
+	{Country: "RE", Currency: "EUR"},
+	{Country: "RO", Currency: "RON", From: date(2005, time.July, 1)},
+	{Country: "RO", Currency: "ROL", Until: date(2007, time.January, 1)},
+	{Country: "RS", Currency: "RSD", From: date(2006, time.October, 1)},
+	{Country: "RU", Currency: "RUB", From: date(1998, time.January, 1)},
+	{Country: "RU", Currency: "RUR", Until: date(1998, time.January, 1)},
+	{Country: "RW", Currency: "RWF"},
+	{Country: "SA", Currency: "SAR"},
+	{Country: "SB", Currency: "SBD"},
+	{Country: "SC", Currency: "SCR"},
+	{Country: "SD", Currency: "SDG", From: date(2007, time.January, 10)},
+	{Country: "SD", Currency: "SDD", Until: date(2007, time.July, 1)},
+	{Country: "SE", Currency: "SEK"},
+	{Country: "SG", Currency: "SGD"},
+	{Country: "SH", Currency: "SHP"},
+	{Country: "SI", Currency: "EUR", From: date(2007, time.January, 1)},
+	{Country: "SI", Currency: "SIT", Until: date(2007, time.January, 15)},
+	{Country: "SJ", Currency: "NOK"},
+	{Country: "SK", Currency: "EUR", From: date(2009, time.January, 1)},
+	{Country: "SK", Currency: "SKK", Until: date(2009, time.January, 17)},
+	{Country: "SL", Currency: "SLE", From: date(2022, time.July, 1)},
+	{Country: "SL", Currency: "SLL", Until: date(2024, time.January, 1)},
+	{Country: "SM", Currency: "EUR"},
+	{Country: "SN", Currency: "XOF"},
+	{Country: "SO", Currency: "SOS"},
+	{Country: "SR", Currency: "SRD", From: date(2004, time.January, 1)},
+	{Country: "SR", Currency: "SRG", Until: date(2004, time.January, 1)},
+	{Country: "SS", Currency: "SSP"},
+	{Country: "ST", Currency: "STN", From: date(2018, time.January, 1)},
+	{Country: "ST", Currency: "STD", Until: date(2018, time.July, 1)},
+	{Country: "SV", Currency: "USD"},
+	{Country: "SV", Currency: "SVC"},
+	{Country: "SX", Currency: "XCG", From: date(2025, time.March, 31)},
+	{Country: "SX", Currency: "ANG", Until: date(2025, time.April, 1)},
+	{Country: "SY", Currency: "SYP"},
+	{Country: "SZ", Currency: "SZL"},
+	{Country: "TC", Currency: "USD"},
+	{Country: "TD", Currency: "XAF"},
+	{Country: "TF", Currency: "EUR"},
+	{Country: "TG", Currency: "XOF"},
+	{Country: "TH", Currency: "THB"},
+	{Country: "TJ", Currency: "TJS"},
+	{Country: "TK", Currency: "NZD"},
+	{Country: "TL", Currency: "USD"},
+	{Country: "TM", Currency: "TMT", From: date(2009, time.January, 1)},
+	{Country: "TM", Currency: "TMM", Until: date(2010, time.January, 1)},
+	{Country: "TN", Currency: "TND"},
+	{Country: "TO", Currency: "TOP"},
+	{Country: "TR", Currency: "TRY", From: date(2005, time.January, 1)},
+	{Country: "TR", Currency: "TRL", Until: date(2006, time.January, 1)},
+	{Country: "TT", Currency: "TTD"},
+	{Country: "TV", Currency: "AUD"},
+	{Country: "TW", Currency: "TWD"},
+	{Country: "TZ", Currency: "TZS"},
+	{Country: "UA", Currency: "UAH"},
+	{Country: "UG", Currency: "UGX"},
+	{Country: "UM", Currency: "USD"},
+	{Country: "US", Currency: "USD"},
+	{Country: "UY", Currency: "UYU"},
+	{Country: "UZ", Currency: "UZS"},
+	{Country: "VA", Currency: "EUR"},
+	{Country: "VC", Currency: "XCD"},
+	{Country: "VE", Currency: "VES", From: date(2018, time.August, 20)},
+	{Country: "VE", Currency: "VED", From: date(2021, time.October, 1)},
+	{Country: "VE", Currency: "VEF", From: date(2008, time.January, 1), Until: date(2018, time.August, 20)},
+	{Country: "VE", Currency: "VEB", Until: date(2008, time.January, 1)},
+	{Country: "VG", Currency: "USD"},
+	{Country: "VI", Currency: "USD"},
+	{Country: "VN", Currency: "VND"},
+	{Country: "VU", Currency: "VUV"},
+	{Country: "WF", Currency: "XPF"},
+	{Country: "WS", Currency: "WST"},
+	{Country: "YE", Currency: "YER"},
+	{Country: "YT", Currency: "EUR"},
+	{Country: "ZA", Currency: "ZAR"},
+	{Country: "ZM", Currency: "ZMW", From: date(2013, time.January, 1)},
+	{Country: "ZM", Currency: "ZMK", Until: date(2013, time.January, 1)},
+	{Country: "ZW", Currency: "ZWG", From: date(2024, time.June, 25)},
+	{Country: "ZW", Currency: "USD", From: date(2009, time.January, 1)},
+	{Country: "ZW", Currency: "ZWL", From: date(2019, time.June, 24), Until: date(2024, time.September, 1)},
+}
+
+var (
+	// byCountry is an index of tenders by country code.
+	byCountry = map[string][]tender{}
+
+	// byCurrency is an index of the countries that use each currency, sorted
+	// by country code.
+	byCurrency = map[string][]string{}
+)
+
+func init() {
+	for _, x := range tenders {
+		byCountry[x.Country] = append(byCountry[x.Country], x)
+
+		byCurrency[x.Currency] = append(byCurrency[x.Currency], x.Country)
+	}
+
+	for _, countries := range byCurrency {
+		sort.Strings(countries)
+	}
+}
+
+// CurrenciesForCountry returns the codes of the currencies that were legal
+// tender in a country or territory at time t.
+//
+// country is an ISO 3166-1 alpha-2 code, such as "US". The currencies are
+// returned in order of preference, such that the first element is the most
+// appropriate default currency for a customer in that country. Some
+// countries, such as Panama ("PAB" and "USD"), have multiple legal tenders.
+//
+// It returns an empty slice if the country is not known, or no currency was
+// legal tender at time t.
+func CurrenciesForCountry(country string, t time.Time) []string {
+	var codes []string
+
+	for _, x := range byCountry[country] {
+		if x.isLegalTenderOn(t) {
+			codes = append(codes, x.Currency)
+		}
+	}
+
+	return codes
+}
+
+// CountriesUsing returns the ISO 3166-1 alpha-2 codes of the countries and
+// territories in which the currency c is, or has been, legal tender.
+//
+// The codes are sorted alphabetically. It returns an empty slice if c is not
+// known to be legal tender in any country, as is the case for funds, precious
+// metals and custom currencies.
+func CountriesUsing(c string) []string {
+	return append([]string(nil), byCurrency[c]...)
+}
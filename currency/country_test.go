@@ -0,0 +1,84 @@
+package currency_test
+
+import (
+	"time"
+
+	. "github.com/dogmatiq/dosh/currency"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("func CurrenciesForCountry()", func() {
+	DescribeTable(
+		"it returns the currencies that were legal tender at the given time",
+		func(country string, t time.Time, expect []string) {
+			Expect(CurrenciesForCountry(country, t)).To(Equal(expect))
+		},
+		Entry("single currency", "US", time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC), []string{"USD"}),
+		Entry("multiple currencies", "PA", time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC), []string{"PAB", "USD"}),
+		Entry("before a change of currency", "HR", time.Date(2022, time.December, 31, 23, 59, 59, 0, time.UTC), []string{"HRK"}),
+		Entry("at the start of a dual circulation period", "HR", time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC), []string{"EUR", "HRK"}),
+		Entry("at the end of a dual circulation period", "HR", time.Date(2023, time.January, 14, 23, 59, 59, 0, time.UTC), []string{"EUR", "HRK"}),
+		Entry("after a change of currency", "HR", time.Date(2023, time.January, 15, 0, 0, 0, 0, time.UTC), []string{"EUR"}),
+		Entry("during a dual circulation period", "DE", time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC), []string{"EUR", "DEM"}),
+		Entry("multiple currencies with changes over time", "ZW", time.Date(2024, time.July, 1, 0, 0, 0, 0, time.UTC), []string{"ZWG", "USD", "ZWL"}),
+	)
+
+	DescribeTable(
+		"it returns an empty slice if no currencies were legal tender",
+		func(country string) {
+			Expect(CurrenciesForCountry(country, time.Now())).To(BeEmpty())
+		},
+		Entry("unknown country", "ZZ"),
+		Entry("lowercase country code", "us"),
+	)
+})
+
+var _ = Describe("func CountriesUsing()", func() {
+	DescribeTable(
+		"it returns the countries that use the currency",
+		func(c string, expect []string) {
+			Expect(CountriesUsing(c)).To(Equal(expect))
+		},
+		Entry("single country", "JPY", []string{"JP"}),
+		Entry("multiple countries", "CHF", []string{"CH", "LI"}),
+		Entry("historic currency", "HRK", []string{"HR"}),
+	)
+
+	It("includes countries in which the currency is not the primary currency", func() {
+		Expect(CountriesUsing("USD")).To(ContainElements("EC", "PA", "US", "ZW"))
+	})
+
+	DescribeTable(
+		"it returns an empty slice if the currency is not used by any country",
+		func(c string) {
+			Expect(CountriesUsing(c)).To(BeEmpty())
+		},
+		Entry("fund", "USN"),
+		Entry("metal", "XAU"),
+		Entry("unknown", "XYZ"),
+	)
+
+	It("refers only to known currencies", func() {
+		dates := []time.Time{
+			time.Date(1990, time.January, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(2010, time.January, 1, 0, 0, 0, 0, time.UTC),
+			time.Now(),
+		}
+
+		for a := 'A'; a <= 'Z'; a++ {
+			for b := 'A'; b <= 'Z'; b++ {
+				country := string([]rune{a, b})
+
+				for _, t := range dates {
+					for _, c := range CurrenciesForCountry(country, t) {
+						_, ok := Lookup(c)
+						Expect(ok).To(BeTrue(), "%s (used by %s) is unknown", c, country)
+					}
+				}
+			}
+		}
+	})
+})
@@ -0,0 +1,18 @@
+// Package currency provides metadata about the currencies that can be
+// represented by dosh amounts.
+//
+// It includes an embedded copy of the ISO 4217 currency table, which describes
+// each currency's alphabetic and numeric codes, the number of decimal places
+// used by its minor unit, and its English name.
+//
+// The table of historic currencies is a partial subset of ISO 4217 list three,
+// so some withdrawn codes are not recognized by Lookup() or accepted by the
+// ISOHistoric policy.
+//
+// The ISO 4217 table can be generated from the XML files published by the ISO
+// 4217 maintenance agency. To update it, download the "list one" and "list
+// three" XML files to this directory as list-one.xml and list-three.xml
+// respectively, then run "go generate".
+package currency
+
+//go:generate go run ../cmd/dosh-gen-currency -list-one list-one.xml -list-three list-three.xml -out iso4217.go
@@ -0,0 +1,146 @@
+package currency
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// successor describes the currency that replaced a withdrawn currency.
+type successor struct {
+	Code string
+	Rate string
+}
+
+// successors is a map of currency code to the currency that replaced it.
+//
+// The rate is the number of units of the withdrawn currency that are
+// equivalent to one unit of its successor. For currencies that were replaced
+// by the Euro, the rate is the irrevocable conversion rate fixed by the
+// Council of the European Union.
+var successors = map[string]successor{
+	"ATS": {"EUR", "13.7603"},
+	"AZM": {"AZN", "5000"},
+	"ANG": {"XCG", "1"},
+	"BEF": {"EUR", "40.3399"},
+	"BGL": {"BGN", "1000"},
+	"BYR": {"BYN", "10000"},
+	"CSD": {"RSD", "1"},
+	"CYP": {"EUR", "0.585274"},
+	"DEM": {"EUR", "1.95583"},
+	"EEK": {"EUR", "15.6466"},
+	"ESP": {"EUR", "166.386"},
+	"FIM": {"EUR", "5.94573"},
+	"FRF": {"EUR", "6.55957"},
+	"GHC": {"GHS", "10000"},
+	"GRD": {"EUR", "340.750"},
+	"HRK": {"EUR", "7.53450"},
+	"IEP": {"EUR", "0.787564"},
+	"ITL": {"EUR", "1936.27"},
+	"LTL": {"EUR", "3.45280"},
+	"LUF": {"EUR", "40.3399"},
+	"LVL": {"EUR", "0.702804"},
+	"MGF": {"MGA", "5"},
+	"MRO": {"MRU", "10"},
+	"MTL": {"EUR", "0.429300"},
+	"MZM": {"MZN", "1000"},
+	"NLG": {"EUR", "2.20371"},
+	"PLZ": {"PLN", "10000"},
+	"PTE": {"EUR", "200.482"},
+	"ROL": {"RON", "10000"},
+	"RUR": {"RUB", "1000"},
+	"SDD": {"SDG", "100"},
+	"SIT": {"EUR", "239.640"},
+	"SKK": {"EUR", "30.1260"},
+	"SLL": {"SLE", "1000"},
+	"SRG": {"SRD", "1000"},
+	"STD": {"STN", "1000"},
+	"TMM": {"TMT", "5000"},
+	"TRL": {"TRY", "1000000"},
+	"VEB": {"VEF", "1000"},
+	"VEF": {"VES", "100000"},
+	"XEU": {"EUR", "1"},
+	"YUM": {"CSD", "1"},
+	"ZMK": {"ZMW", "1000"},
+	"ZWR": {"ZWL", "1000000000000"},
+}
+
+// introductions is a map of currency code to the date on which the currency
+// was introduced.
+//
+// It only includes currencies that replaced some other currency within living
+// memory, and hence whose introduction date is relevant when interpreting
+// historical amounts.
+var introductions = map[string]time.Time{
+	"AZN": date(2006, time.January, 1),
+	"BGN": date(1999, time.July, 5),
+	"BYN": date(2016, time.July, 1),
+	"CSD": date(2003, time.July, 1),
+	"EUR": date(1999, time.January, 1),
+	"GHS": date(2007, time.July, 1),
+	"MGA": date(2003, time.July, 31),
+	"MRU": date(2018, time.January, 1),
+	"MZN": date(2006, time.July, 1),
+	"PLN": date(1995, time.January, 1),
+	"RON": date(2005, time.July, 1),
+	"RSD": date(2006, time.October, 1),
+	"RUB": date(1998, time.January, 1),
+	"SDG": date(2007, time.January, 10),
+	"SLE": date(2022, time.July, 1),
+	"SRD": date(2004, time.January, 1),
+	"STN": date(2018, time.January, 1),
+	"TMT": date(2009, time.January, 1),
+	"TRY": date(2005, time.January, 1),
+	"VED": date(2021, time.October, 1),
+	"VEF": date(2008, time.January, 1),
+	"VES": date(2018, time.August, 20),
+	"XCG": date(2025, time.March, 31),
+	"ZMW": date(2013, time.January, 1),
+	"ZWG": date(2024, time.June, 25),
+	"ZWL": date(2009, time.February, 2),
+}
+
+// historicMinorUnits is a map of currency code to the number of decimal places
+// used by the minor unit of historic currencies.
+//
+// ISO 4217 does not publish this information for historic currencies. Those
+// not listed here are assumed to have used a minor unit of 2 decimal places.
+var historicMinorUnits = map[string]int{
+	"ADP": 0,
+	"BEF": 0,
+	"BYR": 0,
+	"ESP": 0,
+	"GRD": 0,
+	"ITL": 0,
+	"LUF": 0,
+	"MGF": 0,
+	"PTE": 0,
+	"TRL": 0,
+	"XEU": NoMinorUnits,
+}
+
+// withHistory returns a copy of i with its introduction date, successor and
+// historic minor units populated.
+func withHistory(i Info) Info {
+	i.Introduced = introductions[i.Code]
+
+	if i.IsHistoric() {
+		i.MinorUnits = 2
+
+		if n, ok := historicMinorUnits[i.Code]; ok {
+			i.MinorUnits = n
+		}
+	}
+
+	if s, ok := successors[i.Code]; ok {
+		i.Successor = s.Code
+		i.SuccessorRate = decimal.RequireFromString(s.Rate)
+	}
+
+	return i
+}
+
+// date returns the time at the start of the given day in UTC.
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
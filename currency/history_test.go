@@ -0,0 +1,65 @@
+package currency_test
+
+import (
+	"time"
+
+	. "github.com/dogmatiq/dosh/currency"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("historic currencies", func() {
+	It("includes the withdrawal date", func() {
+		i, ok := Lookup("DEM")
+		Expect(ok).To(BeTrue())
+		Expect(i.IsHistoric()).To(BeTrue())
+		Expect(i.Withdrawn).To(Equal(time.Date(2002, time.March, 1, 0, 0, 0, 0, time.UTC)))
+	})
+
+	It("includes the successor currency and rate", func() {
+		i, ok := Lookup("VEF")
+		Expect(ok).To(BeTrue())
+		Expect(i.Successor).To(Equal("VES"))
+		Expect(i.SuccessorRate.String()).To(Equal("100000"))
+	})
+
+	It("includes the introduction date of the successor currency", func() {
+		i, ok := Lookup("VES")
+		Expect(ok).To(BeTrue())
+		Expect(i.IsHistoric()).To(BeFalse())
+		Expect(i.Introduced).To(Equal(time.Date(2018, time.August, 20, 0, 0, 0, 0, time.UTC)))
+	})
+
+	It("prefers the active currency when a numeric code has been reused", func() {
+		i, ok := LookupNumeric(532)
+		Expect(ok).To(BeTrue())
+		Expect(i.Code).To(Equal("XCG"))
+	})
+
+	It("refers only to known currencies", func() {
+		for _, i := range All() {
+			if i.Successor != "" {
+				_, ok := Lookup(i.Successor)
+				Expect(ok).To(BeTrue(), "successor of %s (%s) is unknown", i.Code, i.Successor)
+				Expect(i.SuccessorRate.IsPositive()).To(BeTrue())
+			}
+		}
+	})
+})
+
+var _ = Describe("type Info (history)", func() {
+	Describe("func IsActiveOn()", func() {
+		i, _ := Lookup("HRK")
+		e, _ := Lookup("EUR")
+
+		It("returns true if the currency was in use at the given time", func() {
+			Expect(i.IsActiveOn(time.Date(2022, time.December, 31, 0, 0, 0, 0, time.UTC))).To(BeTrue())
+			Expect(e.IsActiveOn(time.Date(1999, time.January, 1, 0, 0, 0, 0, time.UTC))).To(BeTrue())
+		})
+
+		It("returns false if the currency was not in use at the given time", func() {
+			Expect(i.IsActiveOn(time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC))).To(BeFalse())
+			Expect(e.IsActiveOn(time.Date(1998, time.December, 31, 0, 0, 0, 0, time.UTC))).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,140 @@
+package currency
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// GramsPerTroyOunce is the mass of one troy ounce, in grams.
+//
+// It is the unit of measure for precious metals such as "XAU" (gold).
+var GramsPerTroyOunce = decimal.RequireFromString("31.1034768")
+
+// NoMinorUnits is the value of Info.MinorUnits for currencies that do not have
+// a minor unit, such as precious metals and the "XXX" code.
+//
+// ISO 4217 represents this as "N.A." (not applicable).
+const NoMinorUnits = -1
+
+// Info describes a single currency.
+type Info struct {
+	// Code is the currency's alphabetic code, such as "USD".
+	Code string
+
+	// NumericCode is the currency's ISO 4217 numeric code, such as 840 for
+	// "USD". It is zero if the currency does not have a numeric code.
+	NumericCode int
+
+	// MinorUnits is the number of decimal places used by the currency's minor
+	// unit. For example, the US dollar has a minor unit (the cent) of 2 decimal
+	// places, whereas the Japanese yen has no minor unit, and hence 0 decimal
+	// places.
+	//
+	// It is NoMinorUnits if the concept of a minor unit is not applicable to
+	// the currency.
+	MinorUnits int
+
+	// Name is the English name of the currency.
+	Name string
+
+	// Symbol is the symbol used to represent the currency, such as "$" for
+	// the US dollar. It is empty if the currency's symbol is not known.
+	Symbol string
+
+	// IsFund is true if the currency is a "fund" rather than a currency in
+	// its own right, such as the "USN" (US dollar next day) code.
+	IsFund bool
+
+	// IsMetal is true if the currency is a precious metal, such as "XAU"
+	// (gold). Amounts of precious metals are expressed in troy ounces.
+	IsMetal bool
+
+	// IsTesting is true if the currency code is reserved for testing
+	// purposes, that is, "XTS".
+	//
+	// Such codes are only considered valid when test mode is enabled; see
+	// SetTestMode().
+	IsTesting bool
+
+	// IsNoCurrency is true if the currency code denotes a transaction in which
+	// no currency is involved, that is, "XXX".
+	IsNoCurrency bool
+
+	// Introduced is the date on which the currency was introduced. It is the
+	// zero-value if the introduction date is not known.
+	Introduced time.Time
+
+	// Withdrawn is the date on which the currency was withdrawn from ISO 4217.
+	// It is the zero-value if the currency is still in use.
+	Withdrawn time.Time
+
+	// Successor is the code of the currency that replaced this currency, if
+	// any. It is empty if the currency has no successor.
+	Successor string
+
+	// SuccessorRate is the number of units of this currency that are
+	// equivalent to a single unit of the successor currency. For example, the
+	// rate for the Venezuelan bolívar fuerte ("VEF") is 100000, as it was
+	// redenominated to 1/100000th of a bolívar soberano ("VES").
+	//
+	// It is zero if the currency has no successor.
+	SuccessorRate decimal.Decimal
+
+	// IsCustom is true if the currency is not defined by ISO 4217, but was
+	// instead added by the application using Register().
+	IsCustom bool
+}
+
+// UnitOfMeasure returns the unit in which amounts of the currency are
+// measured, if it is a physical commodity.
+//
+// It returns "troy ounce" for precious metals, and an empty string for all
+// other currencies. See GramsPerTroyOunce.
+func (i Info) UnitOfMeasure() string {
+	if i.IsMetal {
+		return "troy ounce"
+	}
+
+	return ""
+}
+
+// IsHistoric returns true if the currency has been withdrawn.
+func (i Info) IsHistoric() bool {
+	return !i.Withdrawn.IsZero()
+}
+
+// IsActiveOn returns true if the currency was in use at time t.
+//
+// Currencies with an unknown introduction date are considered to have been in
+// use at any time prior to their withdrawal.
+func (i Info) IsActiveOn(t time.Time) bool {
+	if !i.Introduced.IsZero() && t.Before(i.Introduced) {
+		return false
+	}
+
+	if !i.Withdrawn.IsZero() && !t.Before(i.Withdrawn) {
+		return false
+	}
+
+	return true
+}
+
+// HasMinorUnits returns true if the concept of a minor unit is applicable to
+// the currency.
+func (i Info) HasMinorUnits() bool {
+	return i.MinorUnits != NoMinorUnits
+}
+
+// NumericCodeString returns the currency's ISO 4217 numeric code as a
+// zero-padded 3-digit string, such as "008" for the Albanian lek.
+//
+// It returns an empty string if the currency does not have a numeric code.
+func (i Info) NumericCodeString() string {
+	if i.NumericCode == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("%03d", i.NumericCode)
+}
@@ -0,0 +1,41 @@
+package currency_test
+
+import (
+	. "github.com/dogmatiq/dosh/currency"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("type Info", func() {
+	Describe("func HasMinorUnits()", func() {
+		It("returns true if the currency has a minor unit", func() {
+			Expect(Info{MinorUnits: 0}.HasMinorUnits()).To(BeTrue())
+			Expect(Info{MinorUnits: 2}.HasMinorUnits()).To(BeTrue())
+		})
+
+		It("returns false if the currency does not have a minor unit", func() {
+			Expect(Info{MinorUnits: NoMinorUnits}.HasMinorUnits()).To(BeFalse())
+		})
+	})
+
+	Describe("func UnitOfMeasure()", func() {
+		It("returns troy ounces for precious metals", func() {
+			Expect(Info{IsMetal: true}.UnitOfMeasure()).To(Equal("troy ounce"))
+		})
+
+		It("returns an empty string for other currencies", func() {
+			Expect(Info{}.UnitOfMeasure()).To(BeEmpty())
+		})
+	})
+
+	Describe("func NumericCodeString()", func() {
+		It("returns the zero-padded numeric code", func() {
+			Expect(Info{NumericCode: 8}.NumericCodeString()).To(Equal("008"))
+			Expect(Info{NumericCode: 840}.NumericCodeString()).To(Equal("840"))
+		})
+
+		It("returns an empty string if there is no numeric code", func() {
+			Expect(Info{}.NumericCodeString()).To(Equal(""))
+		})
+	})
+})
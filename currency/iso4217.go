@@ -0,0 +1,247 @@
+package currency
+
+import "time"
+
+// iso4217 is the list of currencies currently defined by ISO 4217.
+var iso4217 = []Info{
+	{Code: "AED", NumericCode: 784, MinorUnits: 2, Name: "UAE Dirham"},
+	{Code: "AFN", NumericCode: 971, MinorUnits: 2, Name: "Afghani"},
+	{Code: "ALL", NumericCode: 8, MinorUnits: 2, Name: "Lek"},
+	{Code: "AMD", NumericCode: 51, MinorUnits: 2, Name: "Armenian Dram"},
+	{Code: "AOA", NumericCode: 973, MinorUnits: 2, Name: "Kwanza"},
+	{Code: "ARS", NumericCode: 32, MinorUnits: 2, Name: "Argentine Peso"},
+	{Code: "AUD", NumericCode: 36, MinorUnits: 2, Name: "Australian Dollar"},
+	{Code: "AWG", NumericCode: 533, MinorUnits: 2, Name: "Aruban Florin"},
+	{Code: "AZN", NumericCode: 944, MinorUnits: 2, Name: "Azerbaijan Manat"},
+	{Code: "BAM", NumericCode: 977, MinorUnits: 2, Name: "Convertible Mark"},
+	{Code: "BBD", NumericCode: 52, MinorUnits: 2, Name: "Barbados Dollar"},
+	{Code: "BDT", NumericCode: 50, MinorUnits: 2, Name: "Taka"},
+	{Code: "BGN", NumericCode: 975, MinorUnits: 2, Name: "Bulgarian Lev"},
+	{Code: "BHD", NumericCode: 48, MinorUnits: 3, Name: "Bahraini Dinar"},
+	{Code: "BIF", NumericCode: 108, MinorUnits: 0, Name: "Burundi Franc"},
+	{Code: "BMD", NumericCode: 60, MinorUnits: 2, Name: "Bermudian Dollar"},
+	{Code: "BND", NumericCode: 96, MinorUnits: 2, Name: "Brunei Dollar"},
+	{Code: "BOB", NumericCode: 68, MinorUnits: 2, Name: "Boliviano"},
+	{Code: "BOV", NumericCode: 984, MinorUnits: 2, Name: "Mvdol", IsFund: true},
+	{Code: "BRL", NumericCode: 986, MinorUnits: 2, Name: "Brazilian Real"},
+	{Code: "BSD", NumericCode: 44, MinorUnits: 2, Name: "Bahamian Dollar"},
+	{Code: "BTN", NumericCode: 64, MinorUnits: 2, Name: "Ngultrum"},
+	{Code: "BWP", NumericCode: 72, MinorUnits: 2, Name: "Pula"},
+	{Code: "BYN", NumericCode: 933, MinorUnits: 2, Name: "Belarusian Ruble"},
+	{Code: "BZD", NumericCode: 84, MinorUnits: 2, Name: "Belize Dollar"},
+	{Code: "CAD", NumericCode: 124, MinorUnits: 2, Name: "Canadian Dollar"},
+	{Code: "CDF", NumericCode: 976, MinorUnits: 2, Name: "Congolese Franc"},
+	{Code: "CHE", NumericCode: 947, MinorUnits: 2, Name: "WIR Euro", IsFund: true},
+	{Code: "CHF", NumericCode: 756, MinorUnits: 2, Name: "Swiss Franc"},
+	{Code: "CHW", NumericCode: 948, MinorUnits: 2, Name: "WIR Franc", IsFund: true},
+	{Code: "CLF", NumericCode: 990, MinorUnits: 4, Name: "Unidad de Fomento", IsFund: true},
+	{Code: "CLP", NumericCode: 152, MinorUnits: 0, Name: "Chilean Peso"},
+	{Code: "CNY", NumericCode: 156, MinorUnits: 2, Name: "Yuan Renminbi"},
+	{Code: "COP", NumericCode: 170, MinorUnits: 2, Name: "Colombian Peso"},
+	{Code: "COU", NumericCode: 970, MinorUnits: 2, Name: "Unidad de Valor Real", IsFund: true},
+	{Code: "CRC", NumericCode: 188, MinorUnits: 2, Name: "Costa Rican Colon"},
+	{Code: "CUC", NumericCode: 931, MinorUnits: 2, Name: "Peso Convertible"},
+	{Code: "CUP", NumericCode: 192, MinorUnits: 2, Name: "Cuban Peso"},
+	{Code: "CVE", NumericCode: 132, MinorUnits: 2, Name: "Cabo Verde Escudo"},
+	{Code: "CZK", NumericCode: 203, MinorUnits: 2, Name: "Czech Koruna"},
+	{Code: "DJF", NumericCode: 262, MinorUnits: 0, Name: "Djibouti Franc"},
+	{Code: "DKK", NumericCode: 208, MinorUnits: 2, Name: "Danish Krone"},
+	{Code: "DOP", NumericCode: 214, MinorUnits: 2, Name: "Dominican Peso"},
+	{Code: "DZD", NumericCode: 12, MinorUnits: 2, Name: "Algerian Dinar"},
+	{Code: "EGP", NumericCode: 818, MinorUnits: 2, Name: "Egyptian Pound"},
+	{Code: "ERN", NumericCode: 232, MinorUnits: 2, Name: "Nakfa"},
+	{Code: "ETB", NumericCode: 230, MinorUnits: 2, Name: "Ethiopian Birr"},
+	{Code: "EUR", NumericCode: 978, MinorUnits: 2, Name: "Euro"},
+	{Code: "FJD", NumericCode: 242, MinorUnits: 2, Name: "Fiji Dollar"},
+	{Code: "FKP", NumericCode: 238, MinorUnits: 2, Name: "Falkland Islands Pound"},
+	{Code: "GBP", NumericCode: 826, MinorUnits: 2, Name: "Pound Sterling"},
+	{Code: "GEL", NumericCode: 981, MinorUnits: 2, Name: "Lari"},
+	{Code: "GHS", NumericCode: 936, MinorUnits: 2, Name: "Ghana Cedi"},
+	{Code: "GIP", NumericCode: 292, MinorUnits: 2, Name: "Gibraltar Pound"},
+	{Code: "GMD", NumericCode: 270, MinorUnits: 2, Name: "Dalasi"},
+	{Code: "GNF", NumericCode: 324, MinorUnits: 0, Name: "Guinean Franc"},
+	{Code: "GTQ", NumericCode: 320, MinorUnits: 2, Name: "Quetzal"},
+	{Code: "GYD", NumericCode: 328, MinorUnits: 2, Name: "Guyana Dollar"},
+	{Code: "HKD", NumericCode: 344, MinorUnits: 2, Name: "Hong Kong Dollar"},
+	{Code: "HNL", NumericCode: 340, MinorUnits: 2, Name: "Lempira"},
+	{Code: "HTG", NumericCode: 332, MinorUnits: 2, Name: "Gourde"},
+	{Code: "HUF", NumericCode: 348, MinorUnits: 2, Name: "Forint"},
+	{Code: "IDR", NumericCode: 360, MinorUnits: 2, Name: "Rupiah"},
+	{Code: "ILS", NumericCode: 376, MinorUnits: 2, Name: "New Israeli Sheqel"},
+	{Code: "INR", NumericCode: 356, MinorUnits: 2, Name: "Indian Rupee"},
+	{Code: "IQD", NumericCode: 368, MinorUnits: 3, Name: "Iraqi Dinar"},
+	{Code: "IRR", NumericCode: 364, MinorUnits: 2, Name: "Iranian Rial"},
+	{Code: "ISK", NumericCode: 352, MinorUnits: 0, Name: "Iceland Krona"},
+	{Code: "JMD", NumericCode: 388, MinorUnits: 2, Name: "Jamaican Dollar"},
+	{Code: "JOD", NumericCode: 400, MinorUnits: 3, Name: "Jordanian Dinar"},
+	{Code: "JPY", NumericCode: 392, MinorUnits: 0, Name: "Yen"},
+	{Code: "KES", NumericCode: 404, MinorUnits: 2, Name: "Kenyan Shilling"},
+	{Code: "KGS", NumericCode: 417, MinorUnits: 2, Name: "Som"},
+	{Code: "KHR", NumericCode: 116, MinorUnits: 2, Name: "Riel"},
+	{Code: "KMF", NumericCode: 174, MinorUnits: 0, Name: "Comorian Franc"},
+	{Code: "KPW", NumericCode: 408, MinorUnits: 2, Name: "North Korean Won"},
+	{Code: "KRW", NumericCode: 410, MinorUnits: 0, Name: "Won"},
+	{Code: "KWD", NumericCode: 414, MinorUnits: 3, Name: "Kuwaiti Dinar"},
+	{Code: "KYD", NumericCode: 136, MinorUnits: 2, Name: "Cayman Islands Dollar"},
+	{Code: "KZT", NumericCode: 398, MinorUnits: 2, Name: "Tenge"},
+	{Code: "LAK", NumericCode: 418, MinorUnits: 2, Name: "Lao Kip"},
+	{Code: "LBP", NumericCode: 422, MinorUnits: 2, Name: "Lebanese Pound"},
+	{Code: "LKR", NumericCode: 144, MinorUnits: 2, Name: "Sri Lanka Rupee"},
+	{Code: "LRD", NumericCode: 430, MinorUnits: 2, Name: "Liberian Dollar"},
+	{Code: "LSL", NumericCode: 426, MinorUnits: 2, Name: "Loti"},
+	{Code: "LYD", NumericCode: 434, MinorUnits: 3, Name: "Libyan Dinar"},
+	{Code: "MAD", NumericCode: 504, MinorUnits: 2, Name: "Moroccan Dirham"},
+	{Code: "MDL", NumericCode: 498, MinorUnits: 2, Name: "Moldovan Leu"},
+	{Code: "MGA", NumericCode: 969, MinorUnits: 2, Name: "Malagasy Ariary"},
+	{Code: "MKD", NumericCode: 807, MinorUnits: 2, Name: "Denar"},
+	{Code: "MMK", NumericCode: 104, MinorUnits: 2, Name: "Kyat"},
+	{Code: "MNT", NumericCode: 496, MinorUnits: 2, Name: "Tugrik"},
+	{Code: "MOP", NumericCode: 446, MinorUnits: 2, Name: "Pataca"},
+	{Code: "MRU", NumericCode: 929, MinorUnits: 2, Name: "Ouguiya"},
+	{Code: "MUR", NumericCode: 480, MinorUnits: 2, Name: "Mauritius Rupee"},
+	{Code: "MVR", NumericCode: 462, MinorUnits: 2, Name: "Rufiyaa"},
+	{Code: "MWK", NumericCode: 454, MinorUnits: 2, Name: "Malawi Kwacha"},
+	{Code: "MXN", NumericCode: 484, MinorUnits: 2, Name: "Mexican Peso"},
+	{Code: "MXV", NumericCode: 979, MinorUnits: 2, Name: "Mexican Unidad de Inversion (UDI)", IsFund: true},
+	{Code: "MYR", NumericCode: 458, MinorUnits: 2, Name: "Malaysian Ringgit"},
+	{Code: "MZN", NumericCode: 943, MinorUnits: 2, Name: "Mozambique Metical"},
+	{Code: "NAD", NumericCode: 516, MinorUnits: 2, Name: "Namibia Dollar"},
+	{Code: "NGN", NumericCode: 566, MinorUnits: 2, Name: "Naira"},
+	{Code: "NIO", NumericCode: 558, MinorUnits: 2, Name: "Cordoba Oro"},
+	{Code: "NOK", NumericCode: 578, MinorUnits: 2, Name: "Norwegian Krone"},
+	{Code: "NPR", NumericCode: 524, MinorUnits: 2, Name: "Nepalese Rupee"},
+	{Code: "NZD", NumericCode: 554, MinorUnits: 2, Name: "New Zealand Dollar"},
+	{Code: "OMR", NumericCode: 512, MinorUnits: 3, Name: "Rial Omani"},
+	{Code: "PAB", NumericCode: 590, MinorUnits: 2, Name: "Balboa"},
+	{Code: "PEN", NumericCode: 604, MinorUnits: 2, Name: "Sol"},
+	{Code: "PGK", NumericCode: 598, MinorUnits: 2, Name: "Kina"},
+	{Code: "PHP", NumericCode: 608, MinorUnits: 2, Name: "Philippine Peso"},
+	{Code: "PKR", NumericCode: 586, MinorUnits: 2, Name: "Pakistan Rupee"},
+	{Code: "PLN", NumericCode: 985, MinorUnits: 2, Name: "Zloty"},
+	{Code: "PYG", NumericCode: 600, MinorUnits: 0, Name: "Guarani"},
+	{Code: "QAR", NumericCode: 634, MinorUnits: 2, Name: "Qatari Rial"},
+	{Code: "RON", NumericCode: 946, MinorUnits: 2, Name: "Romanian Leu"},
+	{Code: "RSD", NumericCode: 941, MinorUnits: 2, Name: "Serbian Dinar"},
+	{Code: "RUB", NumericCode: 643, MinorUnits: 2, Name: "Russian Ruble"},
+	{Code: "RWF", NumericCode: 646, MinorUnits: 0, Name: "Rwanda Franc"},
+	{Code: "SAR", NumericCode: 682, MinorUnits: 2, Name: "Saudi Riyal"},
+	{Code: "SBD", NumericCode: 90, MinorUnits: 2, Name: "Solomon Islands Dollar"},
+	{Code: "SCR", NumericCode: 690, MinorUnits: 2, Name: "Seychelles Rupee"},
+	{Code: "SDG", NumericCode: 938, MinorUnits: 2, Name: "Sudanese Pound"},
+	{Code: "SEK", NumericCode: 752, MinorUnits: 2, Name: "Swedish Krona"},
+	{Code: "SGD", NumericCode: 702, MinorUnits: 2, Name: "Singapore Dollar"},
+	{Code: "SHP", NumericCode: 654, MinorUnits: 2, Name: "Saint Helena Pound"},
+	{Code: "SLE", NumericCode: 925, MinorUnits: 2, Name: "Leone"},
+	{Code: "SOS", NumericCode: 706, MinorUnits: 2, Name: "Somali Shilling"},
+	{Code: "SRD", NumericCode: 968, MinorUnits: 2, Name: "Surinam Dollar"},
+	{Code: "SSP", NumericCode: 728, MinorUnits: 2, Name: "South Sudanese Pound"},
+	{Code: "STN", NumericCode: 930, MinorUnits: 2, Name: "Dobra"},
+	{Code: "SVC", NumericCode: 222, MinorUnits: 2, Name: "El Salvador Colon"},
+	{Code: "SYP", NumericCode: 760, MinorUnits: 2, Name: "Syrian Pound"},
+	{Code: "SZL", NumericCode: 748, MinorUnits: 2, Name: "Lilangeni"},
+	{Code: "THB", NumericCode: 764, MinorUnits: 2, Name: "Baht"},
+	{Code: "TJS", NumericCode: 972, MinorUnits: 2, Name: "Somoni"},
+	{Code: "TMT", NumericCode: 934, MinorUnits: 2, Name: "Turkmenistan New Manat"},
+	{Code: "TND", NumericCode: 788, MinorUnits: 3, Name: "Tunisian Dinar"},
+	{Code: "TOP", NumericCode: 776, MinorUnits: 2, Name: "Pa'anga"},
+	{Code: "TRY", NumericCode: 949, MinorUnits: 2, Name: "Turkish Lira"},
+	{Code: "TTD", NumericCode: 780, MinorUnits: 2, Name: "Trinidad and Tobago Dollar"},
+	{Code: "TWD", NumericCode: 901, MinorUnits: 2, Name: "New Taiwan Dollar"},
+	{Code: "TZS", NumericCode: 834, MinorUnits: 2, Name: "Tanzanian Shilling"},
+	{Code: "UAH", NumericCode: 980, MinorUnits: 2, Name: "Hryvnia"},
+	{Code: "UGX", NumericCode: 800, MinorUnits: 0, Name: "Uganda Shilling"},
+	{Code: "USD", NumericCode: 840, MinorUnits: 2, Name: "US Dollar"},
+	{Code: "USN", NumericCode: 997, MinorUnits: 2, Name: "US Dollar (Next day)", IsFund: true},
+	{Code: "UYI", NumericCode: 940, MinorUnits: 0, Name: "Uruguay Peso en Unidades Indexadas (UI)", IsFund: true},
+	{Code: "UYU", NumericCode: 858, MinorUnits: 2, Name: "Peso Uruguayo"},
+	{Code: "UYW", NumericCode: 927, MinorUnits: 4, Name: "Unidad Previsional"},
+	{Code: "UZS", NumericCode: 860, MinorUnits: 2, Name: "Uzbekistan Sum"},
+	{Code: "VED", NumericCode: 926, MinorUnits: 2, Name: "Bolívar Soberano"},
+	{Code: "VES", NumericCode: 928, MinorUnits: 2, Name: "Bolívar Soberano"},
+	{Code: "VND", NumericCode: 704, MinorUnits: 0, Name: "Dong"},
+	{Code: "VUV", NumericCode: 548, MinorUnits: 0, Name: "Vatu"},
+	{Code: "WST", NumericCode: 882, MinorUnits: 2, Name: "Tala"},
+	{Code: "XAF", NumericCode: 950, MinorUnits: 0, Name: "CFA Franc BEAC"},
+	{Code: "XAG", NumericCode: 961, MinorUnits: NoMinorUnits, Name: "Silver", IsMetal: true},
+	{Code: "XAU", NumericCode: 959, MinorUnits: NoMinorUnits, Name: "Gold", IsMetal: true},
+	{Code: "XBA", NumericCode: 955, MinorUnits: NoMinorUnits, Name: "Bond Markets Unit European Composite Unit (EURCO)"},
+	{Code: "XBB", NumericCode: 956, MinorUnits: NoMinorUnits, Name: "Bond Markets Unit European Monetary Unit (E.M.U.-6)"},
+	{Code: "XBC", NumericCode: 957, MinorUnits: NoMinorUnits, Name: "Bond Markets Unit European Unit of Account 9 (E.U.A.-9)"},
+	{Code: "XBD", NumericCode: 958, MinorUnits: NoMinorUnits, Name: "Bond Markets Unit European Unit of Account 17 (E.U.A.-17)"},
+	{Code: "XCD", NumericCode: 951, MinorUnits: 2, Name: "East Caribbean Dollar"},
+	{Code: "XCG", NumericCode: 532, MinorUnits: 2, Name: "Caribbean Guilder"},
+	{Code: "XDR", NumericCode: 960, MinorUnits: NoMinorUnits, Name: "SDR (Special Drawing Right)"},
+	{Code: "XOF", NumericCode: 952, MinorUnits: 0, Name: "CFA Franc BCEAO"},
+	{Code: "XPD", NumericCode: 964, MinorUnits: NoMinorUnits, Name: "Palladium", IsMetal: true},
+	{Code: "XPF", NumericCode: 953, MinorUnits: 0, Name: "CFP Franc"},
+	{Code: "XPT", NumericCode: 962, MinorUnits: NoMinorUnits, Name: "Platinum", IsMetal: true},
+	{Code: "XSU", NumericCode: 994, MinorUnits: NoMinorUnits, Name: "Sucre"},
+	{Code: "XTS", NumericCode: 963, MinorUnits: NoMinorUnits, Name: "Codes specifically reserved for testing purposes", IsTesting: true},
+	{Code: "XUA", NumericCode: 965, MinorUnits: NoMinorUnits, Name: "ADB Unit of Account"},
+	{Code: "XXX", NumericCode: 999, MinorUnits: NoMinorUnits, Name: "The codes assigned for transactions where no currency is involved", IsNoCurrency: true},
+	{Code: "YER", NumericCode: 886, MinorUnits: 2, Name: "Yemeni Rial"},
+	{Code: "ZAR", NumericCode: 710, MinorUnits: 2, Name: "Rand"},
+	{Code: "ZMW", NumericCode: 967, MinorUnits: 2, Name: "Zambian Kwacha"},
+	{Code: "ZWG", NumericCode: 924, MinorUnits: 2, Name: "Zimbabwe Gold"},
+}
+
+// iso4217Historic is a partial list of the currencies that have been withdrawn
+// from ISO 4217.
+//
+// It is not a complete copy of ISO 4217 list three. It includes the withdrawn
+// currencies that have a successor in the successors table, along with other
+// recently withdrawn currencies. Codes such as "SUR" and "ZWD" are not
+// included. The complete list can be produced by running dosh-gen-currency,
+// see the package documentation.
+//
+// ISO 4217 does not publish the minor units of historic currencies, see
+// historicMinorUnits.
+var iso4217Historic = []Info{
+	{Code: "ADP", NumericCode: 20, Name: "Andorran Peseta", Withdrawn: date(2003, time.January, 1)},
+	{Code: "AFA", NumericCode: 4, Name: "Afghani", Withdrawn: date(2003, time.January, 1)},
+	{Code: "ANG", NumericCode: 532, Name: "Netherlands Antillean Guilder", Withdrawn: date(2025, time.April, 1)},
+	{Code: "ATS", NumericCode: 40, Name: "Schilling", Withdrawn: date(2002, time.March, 1)},
+	{Code: "AZM", NumericCode: 31, Name: "Azerbaijanian Manat", Withdrawn: date(2005, time.December, 1)},
+	{Code: "BEF", NumericCode: 56, Name: "Belgian Franc", Withdrawn: date(2002, time.March, 1)},
+	{Code: "BGL", NumericCode: 100, Name: "Lev", Withdrawn: date(2003, time.November, 1)},
+	{Code: "BYR", NumericCode: 974, Name: "Belarusian Ruble", Withdrawn: date(2017, time.January, 1)},
+	{Code: "CSD", NumericCode: 891, Name: "Serbian Dinar", Withdrawn: date(2006, time.October, 1)},
+	{Code: "CYP", NumericCode: 196, Name: "Cyprus Pound", Withdrawn: date(2008, time.January, 1)},
+	{Code: "DEM", NumericCode: 276, Name: "Deutsche Mark", Withdrawn: date(2002, time.March, 1)},
+	{Code: "EEK", NumericCode: 233, Name: "Kroon", Withdrawn: date(2011, time.January, 1)},
+	{Code: "ESP", NumericCode: 724, Name: "Spanish Peseta", Withdrawn: date(2002, time.March, 1)},
+	{Code: "FIM", NumericCode: 246, Name: "Markka", Withdrawn: date(2002, time.March, 1)},
+	{Code: "FRF", NumericCode: 250, Name: "French Franc", Withdrawn: date(2002, time.March, 1)},
+	{Code: "GHC", NumericCode: 288, Name: "Cedi", Withdrawn: date(2008, time.January, 1)},
+	{Code: "GRD", NumericCode: 300, Name: "Drachma", Withdrawn: date(2002, time.March, 1)},
+	{Code: "HRK", NumericCode: 191, Name: "Kuna", Withdrawn: date(2023, time.January, 1)},
+	{Code: "IEP", NumericCode: 372, Name: "Irish Pound", Withdrawn: date(2002, time.March, 1)},
+	{Code: "ITL", NumericCode: 380, Name: "Italian Lira", Withdrawn: date(2002, time.March, 1)},
+	{Code: "LTL", NumericCode: 440, Name: "Lithuanian Litas", Withdrawn: date(2015, time.January, 1)},
+	{Code: "LUF", NumericCode: 442, Name: "Luxembourg Franc", Withdrawn: date(2002, time.March, 1)},
+	{Code: "LVL", NumericCode: 428, Name: "Latvian Lats", Withdrawn: date(2014, time.January, 1)},
+	{Code: "MGF", NumericCode: 450, Name: "Malagasy Franc", Withdrawn: date(2004, time.December, 1)},
+	{Code: "MRO", NumericCode: 478, Name: "Ouguiya", Withdrawn: date(2017, time.December, 1)},
+	{Code: "MTL", NumericCode: 470, Name: "Maltese Lira", Withdrawn: date(2008, time.January, 1)},
+	{Code: "MZM", NumericCode: 508, Name: "Mozambique Metical", Withdrawn: date(2006, time.June, 1)},
+	{Code: "NLG", NumericCode: 528, Name: "Netherlands Guilder", Withdrawn: date(2002, time.March, 1)},
+	{Code: "PLZ", NumericCode: 616, Name: "Zloty", Withdrawn: date(1997, time.January, 1)},
+	{Code: "PTE", NumericCode: 620, Name: "Portuguese Escudo", Withdrawn: date(2002, time.March, 1)},
+	{Code: "ROL", NumericCode: 642, Name: "Leu", Withdrawn: date(2005, time.June, 1)},
+	{Code: "RUR", NumericCode: 810, Name: "Russian Ruble", Withdrawn: date(1998, time.January, 1)},
+	{Code: "SDD", NumericCode: 736, Name: "Sudanese Dinar", Withdrawn: date(2007, time.July, 1)},
+	{Code: "SIT", NumericCode: 705, Name: "Tolar", Withdrawn: date(2007, time.January, 1)},
+	{Code: "SKK", NumericCode: 703, Name: "Slovak Koruna", Withdrawn: date(2009, time.January, 1)},
+	{Code: "SLL", NumericCode: 694, Name: "Leone", Withdrawn: date(2024, time.January, 1)},
+	{Code: "SRG", NumericCode: 740, Name: "Surinam Guilder", Withdrawn: date(2004, time.January, 1)},
+	{Code: "STD", NumericCode: 678, Name: "Dobra", Withdrawn: date(2017, time.December, 1)},
+	{Code: "TMM", NumericCode: 795, Name: "Turkmenistan Manat", Withdrawn: date(2009, time.January, 1)},
+	{Code: "TRL", NumericCode: 792, Name: "Turkish Lira", Withdrawn: date(2005, time.December, 1)},
+	{Code: "VEB", NumericCode: 862, Name: "Bolivar", Withdrawn: date(2008, time.January, 1)},
+	{Code: "VEF", NumericCode: 937, Name: "Bolivar", Withdrawn: date(2018, time.August, 1)},
+	{Code: "XEU", NumericCode: 954, Name: "European Currency Unit (E.C.U)", Withdrawn: date(1999, time.January, 1)},
+	{Code: "YUM", NumericCode: 891, Name: "New Dinar", Withdrawn: date(2003, time.July, 1)},
+	{Code: "ZMK", NumericCode: 894, Name: "Zambian Kwacha", Withdrawn: date(2013, time.January, 1)},
+	{Code: "ZWL", NumericCode: 932, Name: "Zimbabwe Dollar", Withdrawn: date(2024, time.September, 1)},
+	{Code: "ZWR", NumericCode: 935, Name: "Zimbabwe Dollar", Withdrawn: date(2009, time.June, 1)},
+}
@@ -0,0 +1,80 @@
+package currency
+
+import "sort"
+
+var (
+	// all is the list of all known currencies, sorted by alphabetic code.
+	all []Info
+
+	// byCode is an index of all known currencies by alphabetic code.
+	byCode = map[string]Info{}
+
+	// byNumericCode is an index of all known currencies by numeric code.
+	//
+	// Numeric codes are occasionally reused by ISO 4217, in which case the
+	// active currency takes precedence over the historic one.
+	byNumericCode = map[int]Info{}
+)
+
+func init() {
+	for _, i := range iso4217 {
+		i = withSymbol(withHistory(i))
+		all = append(all, i)
+		byCode[i.Code] = i
+		byNumericCode[i.NumericCode] = i
+	}
+
+	for _, i := range iso4217Historic {
+		i = withSymbol(withHistory(i))
+		all = append(all, i)
+		byCode[i.Code] = i
+
+		if _, ok := byNumericCode[i.NumericCode]; !ok {
+			byNumericCode[i.NumericCode] = i
+		}
+	}
+
+	sort.Slice(all, func(a, b int) bool {
+		return all[a].Code < all[b].Code
+	})
+}
+
+// Lookup returns information about the currency with the given alphabetic
+// code, which may be a historic currency or a custom currency added with
+// Register().
+//
+// ok is false if c is not a known currency code. Codes are case-sensitive;
+// ISO 4217 codes are always uppercase.
+func Lookup(c string) (_ Info, ok bool) {
+	if i, ok := byCode[c]; ok {
+		return i, true
+	}
+
+	return lookupCustom(c)
+}
+
+// LookupNumeric returns information about the currency with the given ISO
+// 4217 numeric code.
+//
+// ok is false if n is not a known numeric code.
+func LookupNumeric(n int) (_ Info, ok bool) {
+	if n == 0 {
+		return Info{}, false
+	}
+
+	i, ok := byNumericCode[n]
+	return i, ok
+}
+
+// All returns information about all known currencies, including historic
+// and custom currencies, sorted by alphabetic code.
+func All() []Info {
+	result := append([]Info(nil), all...)
+	result = append(result, allCustom()...)
+
+	sort.Slice(result, func(a, b int) bool {
+		return result[a].Code < result[b].Code
+	})
+
+	return result
+}
@@ -0,0 +1,71 @@
+package currency_test
+
+import (
+	. "github.com/dogmatiq/dosh/currency"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("func Lookup()", func() {
+	DescribeTable(
+		"it returns information about ISO 4217 currencies",
+		func(c string, expect Info) {
+			i, ok := Lookup(c)
+			Expect(ok).To(BeTrue())
+			Expect(i).To(Equal(expect))
+		},
+		Entry("2 decimal places", "USD", Info{Code: "USD", NumericCode: 840, MinorUnits: 2, Name: "US Dollar", Symbol: "$"}),
+		Entry("0 decimal places", "JPY", Info{Code: "JPY", NumericCode: 392, MinorUnits: 0, Name: "Yen", Symbol: "¥"}),
+		Entry("3 decimal places", "KWD", Info{Code: "KWD", NumericCode: 414, MinorUnits: 3, Name: "Kuwaiti Dinar"}),
+		Entry("4 decimal places", "CLF", Info{Code: "CLF", NumericCode: 990, MinorUnits: 4, Name: "Unidad de Fomento", IsFund: true}),
+		Entry("fund", "USN", Info{Code: "USN", NumericCode: 997, MinorUnits: 2, Name: "US Dollar (Next day)", IsFund: true}),
+		Entry("metal", "XAU", Info{Code: "XAU", NumericCode: 959, MinorUnits: NoMinorUnits, Name: "Gold", IsMetal: true}),
+	)
+
+	DescribeTable(
+		"it returns false if the currency code is unknown",
+		func(c string) {
+			_, ok := Lookup(c)
+			Expect(ok).To(BeFalse())
+		},
+		Entry("empty", ""),
+		Entry("unassigned", "ZZZ"),
+		Entry("lowercase", "usd"),
+	)
+})
+
+var _ = Describe("func LookupNumeric()", func() {
+	It("returns information about the currency with the given numeric code", func() {
+		i, ok := LookupNumeric(8)
+		Expect(ok).To(BeTrue())
+		Expect(i.Code).To(Equal("ALL"))
+	})
+
+	DescribeTable(
+		"it returns false if the numeric code is unknown",
+		func(n int) {
+			_, ok := LookupNumeric(n)
+			Expect(ok).To(BeFalse())
+		},
+		Entry("zero", 0),
+		Entry("unassigned", 1),
+		Entry("negative", -840),
+	)
+})
+
+var _ = Describe("func All()", func() {
+	It("returns the currencies sorted by code", func() {
+		all := All()
+		Expect(all).NotTo(BeEmpty())
+
+		for n := 1; n < len(all); n++ {
+			Expect(all[n-1].Code < all[n].Code).To(BeTrue())
+		}
+	})
+
+	It("returns a copy of the underlying table", func() {
+		All()[0].Code = "<modified>"
+		Expect(All()[0].Code).NotTo(Equal("<modified>"))
+	})
+})
@@ -0,0 +1,106 @@
+package currency
+
+import (
+	"strings"
+	"sync/atomic"
+)
+
+// Normalizer rewrites non-canonical currency codes, such as "rmb" or "US$",
+// to their canonical form, such as "CNY" or "USD".
+//
+// The normalizer that is currently in effect is used by Normalize(), which in
+// turn is used when parsing amounts from their text and JSON representations.
+// By default no normalizer is in effect, and codes must be supplied in their
+// canonical form.
+type Normalizer struct {
+	// Aliases is a map of alternative codes to their canonical form. The keys
+	// must be uppercase if FoldCase is true.
+	//
+	// See CommonAliases() for a list of frequently encountered aliases.
+	Aliases map[string]string
+
+	// FoldCase, if true, causes codes to be converted to uppercase before they
+	// are looked up in Aliases. The codes of custom currencies added with
+	// Register() are never case-folded.
+	FoldCase bool
+
+	// OnRewrite, if non-nil, is called whenever a code is rewritten. It may be
+	// used to log or otherwise report non-canonical input.
+	OnRewrite func(from, to string)
+}
+
+// Normalize returns the canonical form of c.
+//
+// rewritten is true if the returned code differs from c.
+func (n *Normalizer) Normalize(c string) (code string, rewritten bool) {
+	code = c
+
+	if !isCustom(c) {
+		if n.FoldCase {
+			code = strings.ToUpper(code)
+		}
+
+		if alias, ok := n.Aliases[code]; ok {
+			code = alias
+		}
+	}
+
+	if code == c {
+		return c, false
+	}
+
+	if n.OnRewrite != nil {
+		n.OnRewrite(c, code)
+	}
+
+	return code, true
+}
+
+// CommonAliases returns a map of frequently encountered non-ISO currency
+// codes and symbols to their ISO 4217 code, suitable for use as
+// Normalizer.Aliases.
+//
+// A new map is returned on each call, so the caller may modify it.
+func CommonAliases() map[string]string {
+	return map[string]string{
+		"RMB":  "CNY", // renminbi
+		"NIS":  "ILS", // new Israeli shekel
+		"UKP":  "GBP", // UK pound
+		"STG":  "GBP", // sterling
+		"US$":  "USD",
+		"EURO": "EUR",
+		"NTD":  "TWD", // new Taiwan dollar
+		"SFR":  "CHF", // Swiss franc
+		"€":    "EUR",
+		"£":    "GBP",
+		"₹":    "INR",
+		"₪":    "ILS",
+	}
+}
+
+// normalizer is the normalizer that is currently in effect.
+var normalizer atomic.Pointer[Normalizer]
+
+// SetNormalizer sets the normalizer that is used when parsing currency codes,
+// returning the normalizer that was previously in effect.
+//
+// If n is nil, normalization is disabled and codes must be supplied in their
+// canonical form. This is the default.
+//
+// It affects all subsequent parsing performed by dosh. It is intended to be
+// called once, when the application starts.
+func SetNormalizer(n *Normalizer) (prev *Normalizer) {
+	return normalizer.Swap(n)
+}
+
+// Normalize returns the canonical form of c using the current normalizer.
+//
+// rewritten is true if the returned code differs from c. If no normalizer is
+// in effect c is returned unchanged.
+func Normalize(c string) (code string, rewritten bool) {
+	if n := normalizer.Load(); n != nil {
+		return n.Normalize(c)
+	}
+
+	return c, false
+}
@@ -0,0 +1,105 @@
+package currency_test
+
+import (
+	. "github.com/dogmatiq/dosh/currency"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("type Normalizer", func() {
+	Describe("func Normalize()", func() {
+		n := &Normalizer{
+			Aliases:  CommonAliases(),
+			FoldCase: true,
+		}
+
+		DescribeTable(
+			"it rewrites non-canonical codes",
+			func(c, expect string) {
+				code, rewritten := n.Normalize(c)
+				Expect(code).To(Equal(expect))
+				Expect(rewritten).To(BeTrue())
+			},
+			Entry("lowercase alias", "rmb", "CNY"),
+			Entry("mixed case", "Eur", "EUR"),
+			Entry("alias", "NIS", "ILS"),
+			Entry("obsolete code", "UKP", "GBP"),
+			Entry("alias with symbol", "US$", "USD"),
+		)
+
+		It("does not rewrite canonical codes", func() {
+			code, rewritten := n.Normalize("USD")
+			Expect(code).To(Equal("USD"))
+			Expect(rewritten).To(BeFalse())
+		})
+
+		It("does not fold the case of custom currency codes", func() {
+			err := Register(Info{Code: "nrm"})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			code, rewritten := n.Normalize("nrm")
+			Expect(code).To(Equal("nrm"))
+			Expect(rewritten).To(BeFalse())
+		})
+
+		It("does not fold case unless FoldCase is true", func() {
+			n := &Normalizer{Aliases: CommonAliases()}
+
+			code, rewritten := n.Normalize("rmb")
+			Expect(code).To(Equal("rmb"))
+			Expect(rewritten).To(BeFalse())
+		})
+
+		It("reports rewritten codes", func() {
+			var from, to string
+			n := &Normalizer{
+				Aliases: CommonAliases(),
+				OnRewrite: func(f, t string) {
+					from, to = f, t
+				},
+			}
+
+			n.Normalize("RMB")
+			Expect(from).To(Equal("RMB"))
+			Expect(to).To(Equal("CNY"))
+		})
+	})
+})
+
+var _ = Describe("func SetNormalizer()", func() {
+	AfterEach(func() {
+		SetNormalizer(nil)
+	})
+
+	It("does not normalize codes by default", func() {
+		code, rewritten := Normalize("rmb")
+		Expect(code).To(Equal("rmb"))
+		Expect(rewritten).To(BeFalse())
+	})
+
+	It("changes the normalizer used by Normalize()", func() {
+		n := &Normalizer{FoldCase: true}
+		Expect(SetNormalizer(n)).To(BeNil())
+
+		code, rewritten := Normalize("eur")
+		Expect(code).To(Equal("EUR"))
+		Expect(rewritten).To(BeTrue())
+
+		Expect(SetNormalizer(nil)).To(BeIdenticalTo(n))
+	})
+})
+
+var _ = Describe("func CommonAliases()", func() {
+	It("maps only to known currencies", func() {
+		for alias, c := range CommonAliases() {
+			_, ok := Lookup(c)
+			Expect(ok).To(BeTrue(), "%s maps to unknown currency %s", alias, c)
+		}
+	})
+
+	It("returns a new map on each call", func() {
+		CommonAliases()["RMB"] = "<modified>"
+		Expect(CommonAliases()["RMB"]).To(Equal("CNY"))
+	})
+})
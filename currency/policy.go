@@ -0,0 +1,199 @@
+package currency
+
+import (
+	"fmt"
+	"sync/atomic"
+
+	"github.com/dogmatiq/dosh/internal/currency"
+)
+
+// Policy is a rule that determines which currency codes are valid.
+//
+// The policy that is currently in effect is used by ValidateCode(), which in
+// turn is used by every dosh function that accepts a currency code, including
+// those that unmarshal amounts.
+type Policy interface {
+	// ValidateCode returns an error if c is not a valid currency code under
+	// this policy.
+	ValidateCode(c string) error
+}
+
+var (
+	// Lenient is a policy that accepts any currency code that consists of 3
+	// or more uppercase ASCII letters, whether or not it is a known currency,
+	// as well as the codes of custom currencies added with Register().
+	//
+	// The exception is "XTS", the code reserved for testing purposes, which is
+	// only accepted when test mode is enabled by calling SetTestMode().
+	//
+	// It is the default policy.
+	Lenient Policy = lenientPolicy{}
+
+	// ISOActive is a policy that only accepts the codes of currencies that
+	// are currently defined by ISO 4217, and custom currencies added with
+	// Register().
+	ISOActive Policy = isoPolicy{}
+
+	// ISOHistoric is a policy that accepts the codes of currencies that are
+	// currently defined by ISO 4217, those that have been withdrawn, and
+	// custom currencies added with Register().
+	//
+	// The table of withdrawn currencies is a partial subset of ISO 4217, so
+	// some withdrawn codes are rejected. See the package documentation.
+	ISOHistoric Policy = isoPolicy{IncludeHistoric: true}
+)
+
+// AllowList returns a policy that only accepts the given currency codes.
+//
+// Codes of custom currencies are only accepted if they are included in codes.
+func AllowList(codes ...string) Policy {
+	p := allowListPolicy{}
+
+	for _, c := range codes {
+		p[c] = struct{}{}
+	}
+
+	return p
+}
+
+var (
+	// policy is the policy that is currently in effect.
+	policy atomic.Pointer[policyHolder]
+
+	// testMode is true if codes reserved for testing are considered valid.
+	testMode atomic.Bool
+)
+
+// policyHolder is a wrapper around a Policy interface, allowing it to be
+// stored in an atomic.Pointer.
+type policyHolder struct {
+	Policy Policy
+}
+
+// SetPolicy sets the policy that is used to validate currency codes,
+// returning the policy that was previously in effect.
+//
+// It affects all subsequent validation performed by dosh. It is intended to
+// be called once, when the application starts.
+//
+// It panics if p is nil.
+func SetPolicy(p Policy) (prev Policy) {
+	if p == nil {
+		panic("policy must not be nil")
+	}
+
+	if h := policy.Swap(&policyHolder{p}); h != nil {
+		return h.Policy
+	}
+
+	return Lenient
+}
+
+// SetTestMode enables or disables test mode, returning the previous setting.
+//
+// When test mode is enabled, currency codes that are reserved for testing
+// purposes, that is "XTS", are accepted by all policies that would otherwise
+// accept them. Test mode is disabled by default, such that test amounts can
+// not be introduced into production systems.
+func SetTestMode(enabled bool) (prev bool) {
+	return testMode.Swap(enabled)
+}
+
+// CurrentPolicy returns the policy that is currently used to validate
+// currency codes.
+func CurrentPolicy() Policy {
+	if h := policy.Load(); h != nil {
+		return h.Policy
+	}
+
+	return Lenient
+}
+
+// ValidateCode returns an error if c is not a valid currency code under the
+// current policy.
+func ValidateCode(c string) error {
+	return CurrentPolicy().ValidateCode(c)
+}
+
+// lenientPolicy is an implementation of Policy that accepts any syntactically
+// valid currency code.
+type lenientPolicy struct{}
+
+func (lenientPolicy) ValidateCode(c string) error {
+	return validateCommon(c)
+}
+
+// isoPolicy is an implementation of Policy that accepts only ISO 4217
+// currency codes.
+type isoPolicy struct {
+	IncludeHistoric bool
+}
+
+func (p isoPolicy) ValidateCode(c string) error {
+	if err := validateCommon(c); err != nil {
+		return err
+	}
+
+	i, ok := Lookup(c)
+	if !ok {
+		return fmt.Errorf(
+			"currency code (%s) is invalid, it is not an ISO 4217 currency code",
+			c,
+		)
+	}
+
+	if i.IsHistoric() && !p.IncludeHistoric {
+		return fmt.Errorf(
+			"currency code (%s) is invalid, the currency was withdrawn from ISO 4217 on %s",
+			c,
+			i.Withdrawn.Format("2006-01-02"),
+		)
+	}
+
+	return nil
+}
+
+// allowListPolicy is an implementation of Policy that accepts only a specific
+// set of currency codes.
+type allowListPolicy map[string]struct{}
+
+func (p allowListPolicy) ValidateCode(c string) error {
+	if err := validateCommon(c); err != nil {
+		return err
+	}
+
+	if _, ok := p[c]; ok {
+		return nil
+	}
+
+	return fmt.Errorf(
+		"currency code (%s) is invalid, it is not in the list of allowed currency codes",
+		c,
+	)
+}
+
+// validateCommon returns an error if c is not a syntactically valid currency
+// code, or if it is reserved for testing and test mode is disabled.
+//
+// The codes of custom currencies are always considered valid, as they are not
+// subject to the same rules as other currency codes.
+func validateCommon(c string) error {
+	if isCustom(c) {
+		return nil
+	}
+
+	if err := currency.ValidateCode(c); err != nil {
+		return err
+	}
+
+	if !testMode.Load() {
+		if i, ok := byCode[c]; ok && i.IsTesting {
+			return fmt.Errorf(
+				"currency code (%s) is invalid, it is reserved for testing and test mode is disabled",
+				c,
+			)
+		}
+	}
+
+	return nil
+}
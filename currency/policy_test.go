@@ -0,0 +1,118 @@
+package currency_test
+
+import (
+	. "github.com/dogmatiq/dosh/currency"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("func SetPolicy()", func() {
+	AfterEach(func() {
+		SetPolicy(Lenient)
+	})
+
+	It("changes the policy used by ValidateCode()", func() {
+		SetPolicy(ISOActive)
+		Expect(CurrentPolicy()).To(Equal(ISOActive))
+
+		err := ValidateCode("XYZ")
+		Expect(err).To(MatchError("currency code (XYZ) is invalid, it is not an ISO 4217 currency code"))
+	})
+
+	It("returns the previous policy", func() {
+		prev := SetPolicy(ISOActive)
+		Expect(prev).To(Equal(Lenient))
+
+		prev = SetPolicy(Lenient)
+		Expect(prev).To(Equal(ISOActive))
+	})
+
+	It("panics if the policy is nil", func() {
+		Expect(func() {
+			SetPolicy(nil)
+		}).To(PanicWith("policy must not be nil"))
+	})
+})
+
+var _ = Describe("func ValidateCode()", func() {
+	It("uses the lenient policy by default", func() {
+		Expect(CurrentPolicy()).To(Equal(Lenient))
+	})
+})
+
+var _ = Describe("var Lenient", func() {
+	DescribeTable(
+		"it returns nil if the currency code is syntactically valid",
+		func(c string) {
+			err := Lenient.ValidateCode(c)
+			Expect(err).ShouldNot(HaveOccurred())
+		},
+		Entry("ISO-4217 code", "USD"),
+		Entry("non-standard code", "XYZ"),
+		Entry("non-standard code longer than 3 characters", "ABCD"),
+	)
+
+	DescribeTable(
+		"it returns an error if the currency code is syntactically invalid",
+		func(c, expect string) {
+			err := Lenient.ValidateCode(c)
+			Expect(err).To(MatchError(expect))
+		},
+		Entry("empty", "", "currency code is empty, codes must consist only of 3 or more uppercase ASCII letters"),
+		Entry("too short", "X", "currency code (X) is invalid, codes must consist only of 3 or more uppercase ASCII letters"),
+	)
+})
+
+var _ = Describe("var ISOActive", func() {
+	DescribeTable(
+		"it returns nil if the currency code is an active ISO 4217 code",
+		func(c string) {
+			err := ISOActive.ValidateCode(c)
+			Expect(err).ShouldNot(HaveOccurred())
+		},
+		Entry("currency", "USD"),
+		Entry("fund", "USN"),
+		Entry("metal", "XAU"),
+	)
+
+	DescribeTable(
+		"it returns an error if the currency code is not an active ISO 4217 code",
+		func(c, expect string) {
+			err := ISOActive.ValidateCode(c)
+			Expect(err).To(MatchError(expect))
+		},
+		Entry("empty", "", "currency code is empty, codes must consist only of 3 or more uppercase ASCII letters"),
+		Entry("syntactically invalid", "X", "currency code (X) is invalid, codes must consist only of 3 or more uppercase ASCII letters"),
+		Entry("unassigned", "ABCD", "currency code (ABCD) is invalid, it is not an ISO 4217 currency code"),
+		Entry("historic", "DEM", "currency code (DEM) is invalid, the currency was withdrawn from ISO 4217 on 2002-03-01"),
+	)
+})
+
+var _ = Describe("var ISOHistoric", func() {
+	DescribeTable(
+		"it returns nil if the currency code is an active or historic ISO 4217 code",
+		func(c string) {
+			err := ISOHistoric.ValidateCode(c)
+			Expect(err).ShouldNot(HaveOccurred())
+		},
+		Entry("active", "USD"),
+		Entry("historic", "DEM"),
+	)
+
+	It("returns an error if the currency code is not an ISO 4217 code", func() {
+		err := ISOHistoric.ValidateCode("ABCD")
+		Expect(err).To(MatchError("currency code (ABCD) is invalid, it is not an ISO 4217 currency code"))
+	})
+})
+
+var _ = Describe("func AllowList()", func() {
+	It("returns a policy that accepts only the given codes", func() {
+		p := AllowList("USD", "XYZ")
+
+		Expect(p.ValidateCode("USD")).To(Succeed())
+		Expect(p.ValidateCode("XYZ")).To(Succeed())
+		Expect(p.ValidateCode("EUR")).To(MatchError("currency code (EUR) is invalid, it is not in the list of allowed currency codes"))
+		Expect(p.ValidateCode("X")).To(MatchError("currency code (X) is invalid, codes must consist only of 3 or more uppercase ASCII letters"))
+	})
+})
@@ -0,0 +1,130 @@
+package currency
+
+import (
+	"fmt"
+	"sync"
+)
+
+var (
+	// customM guards custom.
+	customM sync.RWMutex
+
+	// custom is an index of custom currencies by alphabetic code.
+	custom = map[string]Info{}
+)
+
+// Register adds a custom currency, such as a loyalty points scheme, a
+// cryptocurrency or an in-game credit.
+//
+// Once registered, the currency is returned by Lookup() and All(), and its
+// code is accepted by every policy other than those returned by AllowList(),
+// which only accept the codes they are given. Dosh treats custom currencies
+// the same as ISO 4217 currencies; for example, i.MinorUnits determines the
+// precision of the currency's minor unit.
+//
+// Custom currency codes are not subject to the usual requirement that codes
+// consist only of uppercase letters. A custom code may consist of between 1
+// and 255 ASCII letters and digits, in any case, such as "1INCH" or "eth". It
+// must not be the same as an ISO 4217 code or a previously registered custom
+// code.
+//
+// i.MinorUnits must not be negative, unless it is NoMinorUnits, which indicates
+// that the concept of a minor unit is not applicable to the currency.
+//
+// i.NumericCode, i.Withdrawn, i.Successor and i.SuccessorRate are ignored.
+func Register(i Info) error {
+	if err := validateCustomCode(i.Code); err != nil {
+		return err
+	}
+
+	if i.MinorUnits < NoMinorUnits {
+		return fmt.Errorf(
+			"cannot register custom currency (%s): minor units (%d) must not be negative, other than NoMinorUnits (%d)",
+			i.Code,
+			i.MinorUnits,
+			NoMinorUnits,
+		)
+	}
+
+	if _, ok := byCode[i.Code]; ok {
+		return fmt.Errorf(
+			"cannot register custom currency (%s): code is already used by ISO 4217",
+			i.Code,
+		)
+	}
+
+	customM.Lock()
+	defer customM.Unlock()
+
+	if _, ok := custom[i.Code]; ok {
+		return fmt.Errorf(
+			"cannot register custom currency (%s): code is already registered",
+			i.Code,
+		)
+	}
+
+	custom[i.Code] = Info{
+		Code:       i.Code,
+		MinorUnits: i.MinorUnits,
+		Name:       i.Name,
+		Symbol:     i.Symbol,
+		IsFund:     i.IsFund,
+		IsMetal:    i.IsMetal,
+		Introduced: i.Introduced,
+		IsCustom:   true,
+	}
+
+	return nil
+}
+
+// isCustom returns true if c is the code of a custom currency.
+func isCustom(c string) bool {
+	_, ok := lookupCustom(c)
+	return ok
+}
+
+// lookupCustom returns information about the custom currency with the given
+// code.
+func lookupCustom(c string) (_ Info, ok bool) {
+	customM.RLock()
+	defer customM.RUnlock()
+
+	i, ok := custom[c]
+	return i, ok
+}
+
+// allCustom returns information about all custom currencies, in no particular
+// order.
+func allCustom() []Info {
+	customM.RLock()
+	defer customM.RUnlock()
+
+	result := make([]Info, 0, len(custom))
+	for _, i := range custom {
+		result = append(result, i)
+	}
+
+	return result
+}
+
+// validateCustomCode returns an error if c is not a valid custom currency
+// code.
+func validateCustomCode(c string) error {
+	if len(c) == 0 || len(c) > 255 {
+		return fmt.Errorf(
+			"cannot register custom currency (%s): codes must consist only of between 1 and 255 ASCII letters and digits",
+			c,
+		)
+	}
+
+	for _, r := range c {
+		if !('A' <= r && r <= 'Z' || 'a' <= r && r <= 'z' || '0' <= r && r <= '9') {
+			return fmt.Errorf(
+				"cannot register custom currency (%s): codes must consist only of between 1 and 255 ASCII letters and digits",
+				c,
+			)
+		}
+	}
+
+	return nil
+}
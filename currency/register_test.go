@@ -0,0 +1,102 @@
+package currency_test
+
+import (
+	. "github.com/dogmatiq/dosh/currency"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("func Register()", func() {
+	It("makes the currency available via Lookup()", func() {
+		err := Register(Info{
+			Code:       "PTSA",
+			MinorUnits: 0,
+			Name:       "Loyalty Points",
+			Symbol:     "pts",
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		i, ok := Lookup("PTSA")
+		Expect(ok).To(BeTrue())
+		Expect(i).To(Equal(Info{
+			Code:       "PTSA",
+			MinorUnits: 0,
+			Name:       "Loyalty Points",
+			Symbol:     "pts",
+			IsCustom:   true,
+		}))
+	})
+
+	It("includes the currency in the result of All()", func() {
+		err := Register(Info{Code: "PTSB"})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		var codes []string
+		for _, i := range All() {
+			codes = append(codes, i.Code)
+		}
+
+		Expect(codes).To(ContainElement("PTSB"))
+	})
+
+	It("allows codes that are not uppercase ASCII letters", func() {
+		err := Register(Info{Code: "1INCH", MinorUnits: 18})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		err = Register(Info{Code: "eth", MinorUnits: 18})
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	It("allows currencies without a minor unit", func() {
+		err := Register(Info{Code: "PTSN", MinorUnits: NoMinorUnits})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		i, _ := Lookup("PTSN")
+		Expect(i.HasMinorUnits()).To(BeFalse())
+	})
+
+	It("causes the code to be accepted by the built-in policies", func() {
+		err := Register(Info{Code: "gem", MinorUnits: 2})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		Expect(Lenient.ValidateCode("gem")).To(Succeed())
+		Expect(ISOActive.ValidateCode("gem")).To(Succeed())
+		Expect(ISOHistoric.ValidateCode("gem")).To(Succeed())
+		Expect(AllowList("gem").ValidateCode("gem")).To(Succeed())
+		Expect(AllowList("USD").ValidateCode("gem")).To(MatchError("currency code (gem) is invalid, it is not in the list of allowed currency codes"))
+	})
+
+	It("ignores the historic and numeric code information", func() {
+		err := Register(Info{Code: "PTSC", NumericCode: 123, Successor: "USD"})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		i, _ := Lookup("PTSC")
+		Expect(i.NumericCode).To(BeZero())
+		Expect(i.Successor).To(BeEmpty())
+
+		_, ok := LookupNumeric(123)
+		Expect(ok).To(BeFalse())
+	})
+
+	DescribeTable(
+		"it returns an error if the currency can not be registered",
+		func(i Info, expect string) {
+			err := Register(i)
+			Expect(err).To(MatchError(expect))
+		},
+		Entry("empty code", Info{}, "cannot register custom currency (): codes must consist only of between 1 and 255 ASCII letters and digits"),
+		Entry("invalid characters", Info{Code: "A B"}, "cannot register custom currency (A B): codes must consist only of between 1 and 255 ASCII letters and digits"),
+		Entry("negative minor units", Info{Code: "PTSD", MinorUnits: -2}, "cannot register custom currency (PTSD): minor units (-2) must not be negative, other than NoMinorUnits (-1)"),
+		Entry("ISO 4217 code", Info{Code: "USD"}, "cannot register custom currency (USD): code is already used by ISO 4217"),
+		Entry("historic ISO 4217 code", Info{Code: "DEM"}, "cannot register custom currency (DEM): code is already used by ISO 4217"),
+	)
+
+	It("returns an error if the code is already registered", func() {
+		err := Register(Info{Code: "PTSE"})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		err = Register(Info{Code: "PTSE"})
+		Expect(err).To(MatchError("cannot register custom currency (PTSE): code is already registered"))
+	})
+})
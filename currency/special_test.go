@@ -0,0 +1,65 @@
+package currency_test
+
+import (
+	. "github.com/dogmatiq/dosh/currency"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("special currency codes", func() {
+	DescribeTable(
+		"it identifies the special codes defined by ISO 4217",
+		func(c string, check func(Info) bool) {
+			i, ok := Lookup(c)
+			Expect(ok).To(BeTrue())
+			Expect(check(i)).To(BeTrue())
+			Expect(i.HasMinorUnits()).To(BeFalse())
+		},
+		Entry("XXX", "XXX", func(i Info) bool { return i.IsNoCurrency }),
+		Entry("XTS", "XTS", func(i Info) bool { return i.IsTesting }),
+		Entry("XAU", "XAU", func(i Info) bool { return i.IsMetal }),
+		Entry("XAG", "XAG", func(i Info) bool { return i.IsMetal }),
+		Entry("XPT", "XPT", func(i Info) bool { return i.IsMetal }),
+		Entry("XPD", "XPD", func(i Info) bool { return i.IsMetal }),
+	)
+
+	It("defines the mass of a troy ounce", func() {
+		Expect(GramsPerTroyOunce.String()).To(Equal("31.1034768"))
+	})
+})
+
+var _ = Describe("func SetTestMode()", func() {
+	AfterEach(func() {
+		SetTestMode(false)
+	})
+
+	DescribeTable(
+		"it causes the testing code to be rejected when disabled",
+		func(p Policy) {
+			err := p.ValidateCode("XTS")
+			Expect(err).To(MatchError("currency code (XTS) is invalid, it is reserved for testing and test mode is disabled"))
+		},
+		Entry("lenient", Lenient),
+		Entry("ISO active", ISOActive),
+		Entry("ISO historic", ISOHistoric),
+		Entry("allow-list", AllowList("XTS")),
+	)
+
+	DescribeTable(
+		"it causes the testing code to be accepted when enabled",
+		func(p Policy) {
+			SetTestMode(true)
+			Expect(p.ValidateCode("XTS")).To(Succeed())
+		},
+		Entry("lenient", Lenient),
+		Entry("ISO active", ISOActive),
+		Entry("ISO historic", ISOHistoric),
+		Entry("allow-list", AllowList("XTS")),
+	)
+
+	It("returns the previous setting", func() {
+		Expect(SetTestMode(true)).To(BeFalse())
+		Expect(SetTestMode(false)).To(BeTrue())
+	})
+})
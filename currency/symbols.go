@@ -0,0 +1,52 @@
+package currency
+
+// symbols is a map of currency code to the symbol used to represent that
+// currency.
+//
+// Symbols are not defined by ISO 4217. Where possible the symbols used are
+// those of the Unicode CLDR "en" locale. Currencies that are typically
+// represented by their code, such as the Swiss franc ("CHF"), are omitted.
+var symbols = map[string]string{
+	"AUD": "A$",
+	"AZN": "₼",
+	"BDT": "৳",
+	"BRL": "R$",
+	"CAD": "CA$",
+	"CNY": "CN¥",
+	"CRC": "₡",
+	"EUR": "€",
+	"GBP": "£",
+	"GEL": "₾",
+	"GHS": "GH₵",
+	"HKD": "HK$",
+	"ILS": "₪",
+	"INR": "₹",
+	"JPY": "¥",
+	"KHR": "៛",
+	"KRW": "₩",
+	"KZT": "₸",
+	"LAK": "₭",
+	"MNT": "₮",
+	"MXN": "MX$",
+	"NGN": "₦",
+	"NZD": "NZ$",
+	"PHP": "₱",
+	"PYG": "₲",
+	"RUB": "₽",
+	"THB": "฿",
+	"TRY": "₺",
+	"TWD": "NT$",
+	"UAH": "₴",
+	"USD": "$",
+	"VND": "₫",
+	"XAF": "FCFA",
+	"XCD": "EC$",
+	"XOF": "F CFA",
+	"XPF": "CFPF",
+}
+
+// withSymbol returns a copy of i with its symbol populated.
+func withSymbol(i Info) Info {
+	i.Symbol = symbols[i.Code]
+	return i
+}
@@ -0,0 +1,119 @@
+package dosh_test
+
+import (
+	. "github.com/dogmatiq/dosh"
+	"github.com/dogmatiq/dosh/currency"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("type Currency", func() {
+	Describe("func NewCurrency()", func() {
+		It("returns a currency with the given code", func() {
+			c := NewCurrency("USD")
+			Expect(c.Code()).To(Equal("USD"))
+		})
+
+		It("panics if the currency code is invalid", func() {
+			Expect(func() {
+				NewCurrency("X")
+			}).To(PanicWith(MatchError("currency code (X) is invalid, codes must consist only of 3 or more uppercase ASCII letters")))
+		})
+	})
+
+	Describe("func NumericCode()", func() {
+		It("returns the numeric code", func() {
+			Expect(NewCurrency("USD").NumericCode()).To(Equal(840))
+		})
+
+		It("returns zero if the currency is not known", func() {
+			Expect(NewCurrency("XYZ").NumericCode()).To(BeZero())
+		})
+	})
+
+	Describe("func MinorUnits()", func() {
+		It("returns the number of decimal places used by the minor unit", func() {
+			n, ok := NewCurrency("KWD").MinorUnits()
+			Expect(ok).To(BeTrue())
+			Expect(n).To(Equal(3))
+
+			n, ok = NewCurrency("JPY").MinorUnits()
+			Expect(ok).To(BeTrue())
+			Expect(n).To(Equal(0))
+		})
+
+		It("returns false if the currency has no minor unit", func() {
+			_, ok := NewCurrency("XAU").MinorUnits()
+			Expect(ok).To(BeFalse())
+		})
+
+		It("returns false if the currency is not known", func() {
+			_, ok := NewCurrency("XYZ").MinorUnits()
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	Describe("func Symbol()", func() {
+		It("returns the currency symbol", func() {
+			Expect(NewCurrency("EUR").Symbol()).To(Equal("€"))
+		})
+
+		It("returns an empty string if the currency has no known symbol", func() {
+			Expect(NewCurrency("CHF").Symbol()).To(BeEmpty())
+			Expect(NewCurrency("XYZ").Symbol()).To(BeEmpty())
+		})
+	})
+
+	Describe("func UnitOfMeasure()", func() {
+		It("returns troy ounces for precious metals", func() {
+			Expect(NewCurrency("XAU").UnitOfMeasure()).To(Equal("troy ounce"))
+		})
+
+		It("returns an empty string for other currencies", func() {
+			Expect(NewCurrency("USD").UnitOfMeasure()).To(BeEmpty())
+		})
+	})
+
+	Describe("func Name()", func() {
+		It("returns the name of the currency", func() {
+			Expect(NewCurrency("USD").Name()).To(Equal("US Dollar"))
+		})
+
+		It("returns an empty string if the currency is not known", func() {
+			Expect(NewCurrency("XYZ").Name()).To(BeEmpty())
+		})
+	})
+
+	Describe("func Info()", func() {
+		It("returns the currency metadata", func() {
+			i, ok := NewCurrency("USD").Info()
+			Expect(ok).To(BeTrue())
+			Expect(i.Code).To(Equal("USD"))
+		})
+	})
+
+	Describe("func Validate()", func() {
+		It("returns nil if the currency is valid", func() {
+			Expect(NewCurrency("XYZ").Validate()).To(Succeed())
+		})
+
+		It("returns an error if the currency is the zero-value", func() {
+			Expect(Currency{}.Validate()).To(MatchError("currency code is empty, codes must consist only of 3 or more uppercase ASCII letters"))
+		})
+
+		It("returns an error if the currency is not valid under the current policy", func() {
+			c := NewCurrency("XYZ")
+
+			currency.SetPolicy(currency.ISOActive)
+			defer currency.SetPolicy(currency.Lenient)
+
+			Expect(c.Validate()).To(MatchError("currency code (XYZ) is invalid, it is not an ISO 4217 currency code"))
+		})
+	})
+
+	Describe("func String()", func() {
+		It("returns the currency code", func() {
+			Expect(NewCurrency("USD").String()).To(Equal("USD"))
+		})
+	})
+})
@@ -0,0 +1,100 @@
+package dosh
+
+import (
+	"errors"
+	"sync/atomic"
+
+	"github.com/dogmatiq/dosh/currency"
+)
+
+// ErrNoCurrency is returned, or used as a panic value, when an operation is
+// performed on an Amount that has no currency.
+//
+// An amount only has no currency if it is the zero-value Amount and the
+// default currency has been disabled by calling SetDefaultCurrency("").
+var ErrNoCurrency = errors.New("amount has no currency, it is the zero-value and there is no default currency")
+
+// defaultCurrency is a pointer to the code of the currency used by zero-value
+// amounts. A nil pointer means that the default currency has never been
+// changed, and hence is "USD".
+var defaultCurrency atomic.Pointer[string]
+
+// DefaultCurrency returns the currency code that is used by zero-value
+// amounts.
+//
+// It returns an empty string if the default currency has been disabled, in
+// which case zero-value amounts have no currency.
+func DefaultCurrency() string {
+	if c := defaultCurrency.Load(); c != nil {
+		return *c
+	}
+
+	return "USD"
+}
+
+// SetDefaultCurrency sets the currency code that is used by zero-value
+// amounts, returning the previous default.
+//
+// The default currency is "USD", unless changed by this function. If c is
+// empty the default currency is disabled, such that the zero-value Amount
+// represents an explicit "unset" state. This is useful for detecting
+// uninitialized Amount values, which would otherwise silently be treated as
+// USD.
+//
+// Operations that depend on the currency of an unset amount fail with
+// ErrNoCurrency. Arithmetic, rounding and comparisons between amounts, such as
+// Add(), Round() and Cmp(), panic with ErrNoCurrency. Operations that already
+// return an error, such as marshaling, Redenominate(), RoundToMinorUnit(),
+// RoundCash(), Allocate() and Split(), return an error that wraps
+// ErrNoCurrency instead.
+//
+// Operations that do not depend on the currency succeed as usual. These
+// include IsZero(), IsPositive(), IsNegative(), IdenticalTo() and
+// LexicallyLessThan(), along with Sum(), Min() and Max() when given a single
+// amount, which is returned unchanged.
+//
+// The default currency applies to the entire process. It is intended to be
+// called once, when the application starts.
+//
+// It panics if c is not empty and is not valid under the current currency
+// policy.
+func SetDefaultCurrency(c string) (prev string) {
+	if c != "" {
+		if err := currency.ValidateCode(c); err != nil {
+			panic(err)
+		}
+	}
+
+	if p := defaultCurrency.Swap(&c); p != nil {
+		return *p
+	}
+
+	return "USD"
+}
+
+// IsSet returns true if the amount's currency was set explicitly; that is, if
+// it is not the zero-value Amount.
+//
+// A zero-value amount is still usable if there is a default currency. Use
+// Validate() to check if an amount is usable.
+func (a Amount) IsSet() bool {
+	return a.cur != ""
+}
+
+// Validate returns an error if the amount has no currency, or if its currency
+// is not valid under the current currency policy.
+func (a Amount) Validate() error {
+	c := a.CurrencyCode()
+	if c == "" {
+		return ErrNoCurrency
+	}
+
+	return currency.ValidateCode(c)
+}
+
+// assertHasCurrency panics if a has no currency.
+func assertHasCurrency(a Amount) {
+	if a.CurrencyCode() == "" {
+		panic(ErrNoCurrency)
+	}
+}
@@ -0,0 +1,165 @@
+package dosh_test
+
+import (
+	"fmt"
+
+	. "github.com/dogmatiq/dosh"
+	"github.com/dogmatiq/dosh/currency"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/shopspring/decimal"
+)
+
+var _ = Describe("type Amount (default currency)", func() {
+	Describe("func SetDefaultCurrency()", func() {
+		AfterEach(func() {
+			SetDefaultCurrency("USD")
+		})
+
+		It("defaults to USD", func() {
+			Expect(DefaultCurrency()).To(Equal("USD"))
+		})
+
+		It("changes the currency of zero-value amounts", func() {
+			SetDefaultCurrency("EUR")
+
+			var a Amount
+			Expect(a.CurrencyCode()).To(Equal("EUR"))
+			Expect(a.Add(Unit("EUR")).IdenticalTo(Unit("EUR"))).To(BeTrue())
+		})
+
+		It("returns the previous default", func() {
+			prev := SetDefaultCurrency("EUR")
+			Expect(prev).To(Equal("USD"))
+
+			prev = SetDefaultCurrency("")
+			Expect(prev).To(Equal("EUR"))
+		})
+
+		It("panics if the currency code is invalid", func() {
+			Expect(func() {
+				SetDefaultCurrency("X")
+			}).To(PanicWith(MatchError("currency code (X) is invalid, codes must consist only of 3 or more uppercase ASCII letters")))
+		})
+
+		It("panics if the currency code is rejected by the current policy", func() {
+			currency.SetPolicy(currency.ISOActive)
+			defer currency.SetPolicy(currency.Lenient)
+
+			Expect(func() {
+				SetDefaultCurrency("XYZ")
+			}).To(PanicWith(MatchError("currency code (XYZ) is invalid, it is not an ISO 4217 currency code")))
+		})
+	})
+
+	Describe("func IsSet()", func() {
+		It("returns true if the currency was set explicitly", func() {
+			Expect(Zero("USD").IsSet()).To(BeTrue())
+		})
+
+		It("returns false for the zero-value, even if there is a default currency", func() {
+			var a Amount
+			Expect(a.IsSet()).To(BeFalse())
+		})
+	})
+
+	Describe("func Validate()", func() {
+		It("returns nil if the amount has a valid currency", func() {
+			Expect(Zero("XYZ").Validate()).To(Succeed())
+		})
+
+		It("returns nil for the zero-value if there is a default currency", func() {
+			var a Amount
+			Expect(a.Validate()).To(Succeed())
+		})
+
+		It("returns an error if the currency is rejected by the current policy", func() {
+			a := Zero("XYZ")
+
+			currency.SetPolicy(currency.ISOActive)
+			defer currency.SetPolicy(currency.Lenient)
+
+			Expect(a.Validate()).To(MatchError("currency code (XYZ) is invalid, it is not an ISO 4217 currency code"))
+		})
+	})
+
+	When("the default currency is disabled", func() {
+		var unset Amount
+
+		BeforeEach(func() {
+			SetDefaultCurrency("")
+		})
+
+		AfterEach(func() {
+			SetDefaultCurrency("USD")
+		})
+
+		It("does not assign a currency to zero-value amounts", func() {
+			Expect(unset.CurrencyCode()).To(BeEmpty())
+			Expect(unset.Validate()).To(Equal(ErrNoCurrency))
+		})
+
+		It("does not affect amounts with an explicit currency", func() {
+			a := Unit("USD")
+			Expect(a.Validate()).To(Succeed())
+			Expect(a.Add(a).IdenticalTo(FromInt("USD", 2))).To(BeTrue())
+		})
+
+		DescribeTable(
+			"it causes operations on zero-value amounts to panic",
+			func(fn func()) {
+				Expect(fn).To(PanicWith(ErrNoCurrency))
+			},
+			Entry("Add() (left-hand side)", func() { unset.Add(Unit("USD")) }),
+			Entry("Add() (right-hand side)", func() { Unit("USD").Add(unset) }),
+			Entry("Sub()", func() { unset.Sub(unset) }),
+			Entry("Abs()", func() { unset.Abs() }),
+			Entry("Neg()", func() { unset.Neg() }),
+			Entry("MulScalar()", func() { unset.MulScalar(decimal.NewFromInt(2)) }),
+			Entry("DivScalar()", func() { unset.DivScalar(decimal.NewFromInt(2)) }),
+			Entry("ModScalar()", func() { unset.ModScalar(decimal.NewFromInt(2)) }),
+			Entry("DivRem()", func() { unset.DivRem(decimal.NewFromInt(2), 2) }),
+			Entry("Round()", func() { unset.Round(2) }),
+			Entry("RoundWith()", func() { unset.RoundWith(2, HalfUp) }),
+			Entry("RoundToIncrement()", func() { unset.RoundToIncrement(decimal.NewFromInt(5), HalfUp) }),
+			Entry("RoundToPricePoint()", func() { unset.RoundToPricePoint(HalfUp, PricePoint{Step: decimal.NewFromInt(1)}) }),
+			Entry("Cmp()", func() { unset.Cmp(Unit("USD")) }),
+		)
+
+		DescribeTable(
+			"it causes marshaling of zero-value amounts to fail",
+			func(fn func() error, expect string) {
+				Expect(fn()).To(MatchError(expect))
+			},
+			Entry("MarshalText()", func() error { _, err := unset.MarshalText(); return err }, "cannot marshal amount to text representation: amount has no currency, it is the zero-value and there is no default currency"),
+			Entry("MarshalBinary()", func() error { _, err := unset.MarshalBinary(); return err }, "cannot marshal amount to binary representation: amount has no currency, it is the zero-value and there is no default currency"),
+			Entry("MarshalJSON()", func() error { _, err := unset.MarshalJSON(); return err }, "cannot marshal amount to JSON representation: amount has no currency, it is the zero-value and there is no default currency"),
+			Entry("MarshalProto()", func() error { _, err := unset.MarshalProto(); return err }, "cannot marshal amount to protocol buffers representation: amount has no currency, it is the zero-value and there is no default currency"),
+			Entry("Redenominate()", func() error { _, err := unset.Redenominate(); return err }, "cannot redenominate amount: amount has no currency, it is the zero-value and there is no default currency"),
+			Entry("RedenominateWith()", func() error { _, err := unset.RedenominateWith(2, HalfUp); return err }, "cannot redenominate amount: amount has no currency, it is the zero-value and there is no default currency"),
+			Entry("RoundToMinorUnit()", func() error { _, err := unset.RoundToMinorUnit(HalfUp); return err }, "cannot round amount to its minor unit: amount has no currency, it is the zero-value and there is no default currency"),
+			Entry("RoundCash()", func() error { _, _, err := unset.RoundCash("CH", HalfUp); return err }, "cannot round amount for cash settlement: amount has no currency, it is the zero-value and there is no default currency"),
+			Entry("Allocate()", func() error { _, err := unset.Allocate(decimal.NewFromInt(1)); return err }, "cannot allocate amount: amount has no currency, it is the zero-value and there is no default currency"),
+			Entry("Split()", func() error { _, err := unset.Split(2, nil); return err }, "cannot split amount into 2 parts: amount has no currency, it is the zero-value and there is no default currency"),
+		)
+
+		It("does not affect operations that do not depend on the currency", func() {
+			Expect(unset.IsZero()).To(BeTrue())
+			Expect(unset.IsPositive()).To(BeFalse())
+			Expect(unset.IsNegative()).To(BeFalse())
+			Expect(unset.IdenticalTo(Amount{})).To(BeTrue())
+			Expect(unset.LexicallyLessThan(Unit("USD"))).To(BeTrue())
+			Expect(Sum(unset).IsSet()).To(BeFalse())
+			Expect(Min(unset).IsSet()).To(BeFalse())
+			Expect(Max(unset).IsSet()).To(BeFalse())
+		})
+
+		It("renders zero-value amounts as having no currency", func() {
+			Expect(unset.String()).To(Equal("<no currency> 0"))
+			Expect(unset.GoString()).To(Equal("money.Amount{}"))
+			Expect(fmt.Sprintf("%#v", unset)).To(Equal("money.Amount{}"))
+			Expect(fmt.Sprintf("%v", unset)).To(Equal("%!v(money.Amount=<no currency> 0)"))
+		})
+	})
+})
@@ -0,0 +1,106 @@
+package dosh
+
+import (
+	"math/big"
+
+	"github.com/dogmatiq/dosh/internal/round"
+	"github.com/shopspring/decimal"
+)
+
+var (
+	bigOne  = big.NewInt(1)
+	bigTwo  = big.NewInt(2)
+	bigFive = big.NewInt(5)
+	bigTen  = big.NewInt(10)
+)
+
+// divExact returns a / b if the result can be represented exactly as a
+// decimal; that is, if the result has a finite number of decimal places.
+//
+// ok is false if b is zero or the result has an infinite number of decimal
+// places.
+func divExact(a, b decimal.Decimal) (_ decimal.Decimal, ok bool) {
+	if b.IsZero() {
+		return decimal.Decimal{}, false
+	}
+
+	// a / b = (coeffA / coeffB) * 10^(expA - expB)
+	num := new(big.Int).Set(a.Coefficient())
+	den := new(big.Int).Set(b.Coefficient())
+	exp := a.Exponent() - b.Exponent()
+
+	if den.Sign() < 0 {
+		num.Neg(num)
+		den.Neg(den)
+	}
+
+	g := new(big.Int).GCD(nil, nil, new(big.Int).Abs(num), den)
+	num.Quo(num, g)
+	den.Quo(den, g)
+
+	// The quotient has a finite decimal representation if and only if the
+	// reduced denominator has no prime factors other than 2 and 5. Each
+	// factor of 2 or 5 is cancelled by multiplying the numerator by the other
+	// factor, and incrementing the negative exponent.
+	for den.Cmp(bigOne) != 0 {
+		switch {
+		case isDivisible(den, bigTen):
+			den.Quo(den, bigTen)
+		case isDivisible(den, bigTwo):
+			den.Quo(den, bigTwo)
+			num.Mul(num, bigFive)
+		case isDivisible(den, bigFive):
+			den.Quo(den, bigFive)
+			num.Mul(num, bigTwo)
+		default:
+			return decimal.Decimal{}, false
+		}
+
+		exp--
+	}
+
+	return decimal.NewFromBigInt(num, exp), true
+}
+
+// isDivisible returns true if a is evenly divisible by b.
+func isDivisible(a, b *big.Int) bool {
+	return new(big.Int).Rem(a, b).Sign() == 0
+}
+
+// quoRem returns a / b rounded to n decimal places using the given rounding
+// mode.
+//
+// exact is true if the quotient was not rounded; that is, if a / b can be
+// represented exactly with n decimal places. It panics if b is zero.
+func quoRem(a, b decimal.Decimal, n int32, mode RoundingMode) (q decimal.Decimal, exact bool, err error) {
+	// q is truncated towards zero, such that a = b × q + r, where r has the
+	// same sign as a and |r| < |b| × 10^-n.
+	q, r := a.QuoRem(b, n)
+
+	if r.IsZero() {
+		return q, true, nil
+	}
+
+	// step is the magnitude of the divisor scaled to the smallest increment of
+	// q, allowing the remainder to be compared to one half of that increment.
+	step := b.Abs().Shift(-n)
+
+	neg := a.IsNegative() != b.IsNegative()
+	odd := !q.Shift(n).Mod(two).IsZero()
+
+	away, err := round.Away(mode, neg, r.Abs().Mul(two).Cmp(step), odd)
+	if err != nil {
+		return decimal.Decimal{}, false, err
+	}
+
+	if away {
+		inc := decimal.New(1, -n)
+		if neg {
+			q = q.Sub(inc)
+		} else {
+			q = q.Add(inc)
+		}
+	}
+
+	return q, false, nil
+}
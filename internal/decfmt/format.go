@@ -0,0 +1,316 @@
+// Package decfmt formats decimal values using the verbs, flags, width and
+// precision supported by the fmt package, without converting them to binary
+// floating-point values.
+package decfmt
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/shopspring/decimal"
+)
+
+// Supports returns true if verb is supported by Format().
+func Supports(verb rune) bool {
+	switch verb {
+	case 'f', 'F', // decimal notation
+		'e', 'E', // scientific notation
+		'g', 'G', // decimal notation, or scientific for large exponents
+		'v': // "default" format
+		return true
+	default:
+		return false
+	}
+}
+
+// Format writes v to st, formatted according to verb and st's flags, width and
+// precision.
+//
+// The output is identical to that produced by the fmt package when formatting
+// a float64 with the same decimal value, except that all digits are exact.
+// Values are rounded to the requested precision using "round half to even",
+// consistent with the fmt package.
+//
+// The '+' and ' ' flags control the sign of non-negative values. The '-' flag
+// pads with spaces on the right, and the '0' flag pads with leading zeros.
+//
+// It panics if verb is not supported.
+func Format(st fmt.State, verb rune, v decimal.Decimal) {
+	prec, hasPrec := st.Precision()
+	if !hasPrec {
+		switch verb {
+		case 'f', 'F', 'e', 'E':
+			prec = 6 // default precision, consistent with the fmt package
+		default:
+			prec = -1 // as many digits as necessary
+		}
+	}
+
+	x := newDigits(v)
+	var buf []byte
+
+	switch verb {
+	case 'f', 'F':
+		buf = x.fmtF(prec)
+	case 'e', 'E':
+		buf = x.fmtE(prec, byte(verb))
+	case 'g', 'G':
+		buf = x.fmtG(prec, byte(verb)-'g'+'e')
+	case 'v':
+		buf = x.fmtG(prec, 'e')
+	default:
+		panic(fmt.Sprintf("unsupported verb (%c)", verb))
+	}
+
+	write(st, x.neg, buf)
+}
+
+// write writes the formatted digits in buf to st, along with the sign and any
+// padding required by st's flags and width.
+func write(st fmt.State, neg bool, buf []byte) {
+	var sign string
+	switch {
+	case neg:
+		sign = "-"
+	case st.Flag('+'):
+		sign = "+"
+	case st.Flag(' '):
+		sign = " "
+	}
+
+	var padding int
+	if w, ok := st.Width(); ok && w > len(sign)+len(buf) {
+		padding = w - len(sign) - len(buf)
+	}
+
+	switch {
+	case st.Flag('-'):
+		writeString(st, sign)
+		st.Write(buf)
+		writeRepeated(st, ' ', padding)
+	case st.Flag('0'):
+		writeString(st, sign)
+		writeRepeated(st, '0', padding)
+		st.Write(buf)
+	default:
+		writeRepeated(st, ' ', padding)
+		writeString(st, sign)
+		st.Write(buf)
+	}
+}
+
+func writeString(st fmt.State, s string) {
+	if s != "" {
+		st.Write([]byte(s))
+	}
+}
+
+func writeRepeated(st fmt.State, b byte, n int) {
+	for ; n > 0; n-- {
+		st.Write([]byte{b})
+	}
+}
+
+// digits is a decimal value represented as a sequence of decimal digits.
+//
+// The value is 0.d[0]d[1]...d[n-1] × 10^dp. The sequence never has trailing
+// zeros, and is empty if the value is zero.
+type digits struct {
+	d   []byte
+	dp  int
+	neg bool
+}
+
+// newDigits returns the digits of v.
+func newDigits(v decimal.Decimal) digits {
+	c := v.Coefficient()
+	neg := c.Sign() < 0
+	s := []byte(c.Abs(c).String())
+
+	x := digits{
+		d:   s,
+		dp:  len(s) + int(v.Exponent()),
+		neg: neg,
+	}
+	x.trim()
+
+	return x
+}
+
+// trim removes trailing zeros from the digits.
+func (x *digits) trim() {
+	n := len(x.d)
+	for n > 0 && x.d[n-1] == '0' {
+		n--
+	}
+
+	x.d = x.d[:n]
+	if n == 0 {
+		x.dp = 0
+	}
+}
+
+// round rounds the value to n significant digits using "round half to even".
+func (x *digits) round(n int) {
+	if n >= len(x.d) {
+		return
+	}
+
+	if n < 0 {
+		// The most significant digit is beyond the rounding position, so the
+		// value is less than half of the smallest representable increment.
+		x.d = x.d[:0]
+		x.dp = 0
+		return
+	}
+
+	var up bool
+	switch {
+	case x.d[n] > '5':
+		up = true
+	case x.d[n] == '5':
+		// There are no trailing zeros, so any further digits mean the value
+		// is more than half way between its neighbors.
+		up = n+1 < len(x.d) || (n > 0 && (x.d[n-1]-'0')%2 != 0)
+	}
+
+	x.d = x.d[:n]
+
+	if !up {
+		x.trim()
+		return
+	}
+
+	for i := n - 1; i >= 0; i-- {
+		if x.d[i] < '9' {
+			x.d[i]++
+			x.d = x.d[:i+1]
+			return
+		}
+	}
+
+	// All of the digits were 9s, so the value is rounded up to the next power
+	// of 10.
+	x.d = append(x.d[:0], '1')
+	x.dp++
+}
+
+// at returns the i'th digit, or '0' if i is out of range.
+func (x *digits) at(i int) byte {
+	if i >= 0 && i < len(x.d) {
+		return x.d[i]
+	}
+	return '0'
+}
+
+// fmtF returns the value in decimal notation with prec digits after the
+// decimal point. If prec is negative, as many digits as necessary are used.
+func (x *digits) fmtF(prec int) []byte {
+	if prec < 0 {
+		prec = max(len(x.d)-x.dp, 0)
+	} else {
+		x.round(x.dp + prec)
+	}
+
+	var buf []byte
+
+	if x.dp > 0 {
+		for i := 0; i < x.dp; i++ {
+			buf = append(buf, x.at(i))
+		}
+	} else {
+		buf = append(buf, '0')
+	}
+
+	if prec > 0 {
+		buf = append(buf, '.')
+		for i := 0; i < prec; i++ {
+			buf = append(buf, x.at(x.dp+i))
+		}
+	}
+
+	return buf
+}
+
+// fmtE returns the value in scientific notation with prec digits after the
+// decimal point. If prec is negative, as many digits as necessary are used.
+//
+// e is the character used to introduce the exponent, either 'e' or 'E'.
+func (x *digits) fmtE(prec int, e byte) []byte {
+	if prec < 0 {
+		prec = max(len(x.d)-1, 0)
+	} else {
+		x.round(prec + 1)
+	}
+
+	buf := []byte{x.at(0)}
+
+	if prec > 0 {
+		buf = append(buf, '.')
+		for i := 1; i <= prec; i++ {
+			buf = append(buf, x.at(i))
+		}
+	}
+
+	exp := 0
+	if len(x.d) != 0 {
+		exp = x.dp - 1
+	}
+
+	buf = append(buf, e)
+	if exp < 0 {
+		buf = append(buf, '-')
+		exp = -exp
+	} else {
+		buf = append(buf, '+')
+	}
+
+	if exp < 10 {
+		buf = append(buf, '0')
+	}
+
+	return strconv.AppendInt(buf, int64(exp), 10)
+}
+
+// fmtG returns the value in either decimal or scientific notation, whichever
+// is more compact, with a total of prec significant digits. If prec is
+// negative, as many digits as necessary are used.
+//
+// e is the character used to introduce the exponent, either 'e' or 'E'.
+func (x *digits) fmtG(prec int, e byte) []byte {
+	shortest := prec < 0
+
+	if shortest {
+		prec = len(x.d)
+	} else {
+		if prec == 0 {
+			prec = 1
+		}
+		x.round(prec)
+	}
+
+	// The logic below mirrors that used by strconv.FormatFloat(), such that
+	// the choice of notation is consistent with the fmt package.
+	eprec := prec
+	if eprec > len(x.d) && len(x.d) >= x.dp {
+		eprec = len(x.d)
+	}
+
+	if shortest {
+		eprec = 6
+	}
+
+	exp := x.dp - 1
+	if exp < -4 || exp >= eprec {
+		if prec > len(x.d) {
+			prec = len(x.d)
+		}
+		return x.fmtE(max(prec-1, 0), e)
+	}
+
+	if prec > x.dp {
+		prec = len(x.d)
+	}
+
+	return x.fmtF(max(prec-x.dp, 0))
+}
@@ -0,0 +1,110 @@
+package decfmt_test
+
+import (
+	"fmt"
+	"strconv"
+
+	. "github.com/dogmatiq/dosh/internal/decfmt"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/shopspring/decimal"
+)
+
+// value adapts a decimal to the fmt.Formatter interface using Format().
+type value struct {
+	d decimal.Decimal
+}
+
+func (v value) Format(st fmt.State, verb rune) {
+	Format(st, verb, v.d)
+}
+
+var _ = Describe("func Format()", func() {
+	DescribeTable(
+		"it produces the same output as the fmt package for values that are exactly representable as a float64",
+		func(format, v string) {
+			f, err := strconv.ParseFloat(v, 64)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			expect := fmt.Sprintf(format, f)
+			actual := fmt.Sprintf(format, value{decimal.RequireFromString(v)})
+			Expect(actual).To(Equal(expect))
+		},
+		Entry("%v", "%v", "10.25"),
+		Entry("%v (zero)", "%v", "0"),
+		Entry("%v (large)", "%v", "12345678"),
+		Entry("%v (small)", "%v", "0.0000125"),
+		Entry("%f", "%f", "10.25"),
+		Entry("%F", "%F", "10.25"),
+		Entry("%.2f", "%.2f", "10.25"),
+		Entry("%.1f (half, rounds to even)", "%.1f", "10.25"),
+		Entry("%.1f (half, rounds to even)", "%.1f", "10.75"),
+		Entry("%.0f (carry)", "%.0f", "9.5"),
+		Entry("%.0f (less than half)", "%.0f", "0.25"),
+		Entry("%.3f (padded with zeros)", "%.3f", "1.5"),
+		Entry("%.2f (negative)", "%.2f", "-10.25"),
+		Entry("%.2f (negative rounds to zero)", "%.2f", "-0.0001220703125"),
+		Entry("%e", "%e", "1234.5"),
+		Entry("%E", "%E", "1234.5"),
+		Entry("%.2e", "%.2e", "1234.5"),
+		Entry("%.0e", "%.0e", "1250"),
+		Entry("%e (small)", "%e", "0.0001220703125"),
+		Entry("%e (zero)", "%e", "0"),
+		Entry("%g", "%g", "1234.5"),
+		Entry("%G (exponent)", "%G", "0.0000125"),
+		Entry("%.3g", "%.3g", "1234.5"),
+		Entry("%.3g (zero)", "%.3g", "0"),
+		Entry("%.0g", "%.0g", "1234.5"),
+		Entry("%.10g", "%.10g", "1234.5"),
+		Entry("%+.2f", "%+.2f", "10.25"),
+		Entry("% .2f", "% .2f", "10.25"),
+		Entry("%10.2f", "%10.2f", "-10.25"),
+		Entry("%-10.2f", "%-10.2f", "-10.25"),
+		Entry("%010.2f", "%010.2f", "-10.25"),
+		Entry("%+010.2f", "%+010.2f", "10.25"),
+		Entry("%2.2f (width less than length)", "%2.2f", "10.25"),
+	)
+
+	DescribeTable(
+		"it formats values exactly, regardless of their precision",
+		func(format, v, expect string) {
+			actual := fmt.Sprintf(format, value{decimal.RequireFromString(v)})
+			Expect(actual).To(Equal(expect))
+		},
+		Entry("%v", "%v", "123456789012345678.123456789", "1.23456789012345678123456789e+17"),
+		Entry("%f", "%.9f", "123456789012345678.123456789", "123456789012345678.123456789"),
+		Entry("%.2f", "%.2f", "123456789012345678.125", "123456789012345678.12"),
+		Entry("%.2f (more than half)", "%.2f", "123456789012345678.1250000001", "123456789012345678.13"),
+		Entry("%.2f (decimal half)", "%.2f", "0.015", "0.02"),
+		Entry("%.1f (decimal fraction)", "%.1f", "0.35", "0.4"),
+		Entry("%f (trailing zeros are ignored)", "%f", "1.50000000", "1.500000"),
+		Entry("%v (trailing zeros are ignored)", "%v", "1.50000000", "1.5"),
+		Entry("%v (positive exponent)", "%v", "1e3", "1000"),
+		Entry("%.0f (carry to new digit)", "%.0f", "999.9", "1000"),
+	)
+
+	It("panics if the verb is not supported", func() {
+		// The fmt package recovers from panics within Format() methods.
+		s := fmt.Sprintf("%d", value{decimal.NewFromInt(1)})
+		Expect(s).To(ContainSubstring("PANIC=Format method: unsupported verb (d)"))
+	})
+})
+
+var _ = Describe("func Supports()", func() {
+	DescribeTable(
+		"it returns true if the verb is supported",
+		func(verb rune, expect bool) {
+			Expect(Supports(verb)).To(Equal(expect))
+		},
+		Entry("f", 'f', true),
+		Entry("F", 'F', true),
+		Entry("e", 'e', true),
+		Entry("E", 'E', true),
+		Entry("g", 'g', true),
+		Entry("G", 'G', true),
+		Entry("v", 'v', true),
+		Entry("d", 'd', false),
+		Entry("s", 's', false),
+	)
+})
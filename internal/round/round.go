@@ -0,0 +1,56 @@
+// Package round implements the rounding decisions that are shared by the dosh
+// and protomoney packages.
+package round
+
+import (
+	"fmt"
+
+	"github.com/dogmatiq/dosh/rounding"
+)
+
+// Away returns true if a value that can not be represented exactly should be
+// rounded away from zero, as opposed to being truncated.
+//
+// neg is true if the value is negative. half is the result of comparing the
+// magnitude of the discarded portion of the value to one half of the smallest
+// representable increment. odd is true if the truncated value is odd.
+//
+// It returns rounding.ErrInexact if m is rounding.Unnecessary. It panics if m
+// is not a recognized rounding mode.
+func Away(m rounding.Mode, neg bool, half int, odd bool) (bool, error) {
+	switch m {
+	case rounding.HalfUp:
+		return half >= 0, nil
+	case rounding.HalfDown:
+		return half > 0, nil
+	case rounding.HalfEven:
+		return half > 0 || (half == 0 && odd), nil
+	case rounding.HalfOdd:
+		return half > 0 || (half == 0 && !odd), nil
+	case rounding.Up:
+		return true, nil
+	case rounding.Down:
+		return false, nil
+	case rounding.Ceiling:
+		return !neg, nil
+	case rounding.Floor:
+		return neg, nil
+	case rounding.Unnecessary:
+		return false, rounding.ErrInexact
+	default:
+		panic(fmt.Sprintf("unrecognized rounding mode (%d)", int(m)))
+	}
+}
+
+// Mirror returns the rounding mode that produces the same result when applied
+// to the negation of a value, such that Ceiling becomes Floor and vice versa.
+func Mirror(m rounding.Mode) rounding.Mode {
+	switch m {
+	case rounding.Ceiling:
+		return rounding.Floor
+	case rounding.Floor:
+		return rounding.Ceiling
+	default:
+		return m
+	}
+}
@@ -4,7 +4,7 @@ import (
 	"errors"
 	"fmt"
 
-	"github.com/dogmatiq/dosh/internal/currency"
+	"github.com/dogmatiq/dosh/currency"
 	"github.com/shopspring/decimal"
 )
 
@@ -13,6 +13,10 @@ func (a Amount) MarshalBinary() ([]byte, error) {
 	c := a.CurrencyCode()
 	n := len(c)
 
+	if n == 0 {
+		return nil, fmt.Errorf("cannot marshal amount to binary representation: %w", ErrNoCurrency)
+	}
+
 	if n > 255 {
 		return nil, fmt.Errorf("cannot marshal amount to binary representation: currency code is %d characters long, maximum is 255", n)
 	}
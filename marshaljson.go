@@ -3,6 +3,7 @@ package dosh
 import (
 	"fmt"
 
+	"github.com/dogmatiq/dosh/currency"
 	"google.golang.org/genproto/googleapis/type/money"
 	"google.golang.org/protobuf/encoding/protojson"
 )
@@ -40,6 +41,9 @@ func (a Amount) MarshalJSON() ([]byte, error) {
 
 // UnmarshalJSON unmarshals an amount from its protocol buffers representation.
 //
+// The currency code is normalized using the current normalizer, as set by
+// currency.SetNormalizer(), before it is validated.
+//
 // NOTE: In order to comply with Go's json.Unmarshaler interface, this method
 // mutates the internals of a, violating Amount's immutability guarantee.
 func (a *Amount) UnmarshalJSON(data []byte) error {
@@ -49,6 +53,8 @@ func (a *Amount) UnmarshalJSON(data []byte) error {
 		return fmt.Errorf("cannot unmarshal amount from JSON representation: %w", err)
 	}
 
+	pb.CurrencyCode, _ = currency.Normalize(pb.CurrencyCode)
+
 	if err := a.unmarshalProto(&pb); err != nil {
 		return fmt.Errorf("cannot unmarshal amount from JSON representation: %w", err)
 	}
@@ -4,6 +4,7 @@ import (
 	"math"
 
 	. "github.com/dogmatiq/dosh"
+	"github.com/dogmatiq/dosh/currency"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
@@ -53,6 +54,19 @@ var _ = Describe("type Amount (JSON marshaling)", func() {
 			Expect(a.Magnitude().Equal(m))
 		})
 
+		It("normalizes the currency code using the current normalizer", func() {
+			currency.SetNormalizer(&currency.Normalizer{
+				Aliases:  currency.CommonAliases(),
+				FoldCase: true,
+			})
+			defer currency.SetNormalizer(nil)
+
+			var a Amount
+			err := a.UnmarshalJSON([]byte(`{"currency_code":"Nis","units":"10"}`))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(a.CurrencyCode()).To(Equal("ILS"))
+		})
+
 		DescribeTable(
 			"it returns an error if the JSON message is invalid",
 			func(data string, expect string) {
@@ -4,7 +4,7 @@ import (
 	"errors"
 	"fmt"
 
-	"github.com/dogmatiq/dosh/internal/currency"
+	"github.com/dogmatiq/dosh/currency"
 	"github.com/shopspring/decimal"
 	"google.golang.org/genproto/googleapis/type/money"
 )
@@ -60,6 +60,10 @@ func (a *Amount) UnmarshalProto(pb *money.Money) error {
 // without providing any protocol-buffer-specific error information, allowing it
 // to be used by both MarshalJSON() and MarshalProto().
 func (a Amount) marshalProto() (*money.Money, error) {
+	if a.CurrencyCode() == "" {
+		return nil, ErrNoCurrency
+	}
+
 	if !a.mag.BigInt().IsInt64() {
 		return nil, errors.New("magnitude's integer component overflows int64")
 	}
@@ -6,17 +6,28 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/dogmatiq/dosh/internal/currency"
+	"github.com/dogmatiq/dosh/currency"
 	"github.com/shopspring/decimal"
 )
 
 // MarshalText mashals an amount to its text representation.
+//
+// The magnitude is rendered using the current text format, as set by
+// SetTextFormat().
 func (a Amount) MarshalText() (text []byte, err error) {
-	return []byte(a.String()), nil
+	if a.CurrencyCode() == "" {
+		return nil, fmt.Errorf("cannot marshal amount to text representation: %w", ErrNoCurrency)
+	}
+
+	f := TextFormat(textFormat.Load())
+	return []byte(a.Text(f)), nil
 }
 
 // UnmarshalText unmarshals an amount from its text representation.
 //
+// The currency code is normalized using the current normalizer, as set by
+// currency.SetNormalizer(), before it is validated.
+//
 // NOTE: In order to comply with Go's encoding.TextUnmarshaler interface, this
 // method mutates the internals of a, violating Amount's immutability guarantee.
 func (a *Amount) UnmarshalText(text []byte) error {
@@ -25,7 +36,7 @@ func (a *Amount) UnmarshalText(text []byte) error {
 		return errors.New("cannot unmarshal amount from text representation: data must have currency and magnitude components separated by a single space")
 	}
 
-	c := string(text[:n])
+	c, _ := currency.Normalize(string(text[:n]))
 	m := string(text[n+1:])
 
 	if err := currency.ValidateCode(c); err != nil {
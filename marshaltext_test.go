@@ -2,6 +2,7 @@ package dosh_test
 
 import (
 	. "github.com/dogmatiq/dosh"
+	"github.com/dogmatiq/dosh/currency"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
@@ -17,6 +18,17 @@ var _ = Describe("type Amount (text marshaling)", func() {
 			Expect(err).ShouldNot(HaveOccurred())
 			Expect(data).To(Equal([]byte("XYZ 10.123")))
 		})
+
+		It("uses the current text format", func() {
+			prev := SetTextFormat(MinorUnitText)
+			defer SetTextFormat(prev)
+
+			a := FromString("USD", "10.1")
+
+			data, err := a.MarshalText()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(data).To(Equal([]byte("USD 10.10")))
+		})
 	})
 
 	Describe("func UnmarshalText()", func() {
@@ -32,6 +44,25 @@ var _ = Describe("type Amount (text marshaling)", func() {
 			Expect(a.Magnitude().Equal(m))
 		})
 
+		It("normalizes the currency code using the current normalizer", func() {
+			currency.SetNormalizer(&currency.Normalizer{
+				Aliases:  currency.CommonAliases(),
+				FoldCase: true,
+			})
+			defer currency.SetNormalizer(nil)
+
+			var a Amount
+			err := a.UnmarshalText([]byte("rmb 10.123"))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(a.CurrencyCode()).To(Equal("CNY"))
+		})
+
+		It("does not normalize the currency code by default", func() {
+			var a Amount
+			err := a.UnmarshalText([]byte("Eur 10.123"))
+			Expect(err).To(MatchError("cannot unmarshal amount from text representation: currency code (Eur) is invalid, codes must consist only of 3 or more uppercase ASCII letters"))
+		})
+
 		DescribeTable(
 			"it returns an error if the data is invalid",
 			func(data string, expect string) {
@@ -7,12 +7,14 @@ import "github.com/shopspring/decimal"
 // That is, if a is negative, it returns its inverse (a positive magnitude),
 // otherwise it returns a unchanged.
 func (a Amount) Abs() Amount {
+	assertHasCurrency(a)
 	a.mag = a.mag.Abs()
 	return a
 }
 
 // Neg returns -a.
 func (a Amount) Neg() Amount {
+	assertHasCurrency(a)
 	a.mag = a.mag.Neg()
 	return a
 }
@@ -37,6 +39,7 @@ func (a Amount) Sub(b Amount) Amount {
 
 // MulScalar returns a * b, where b is a scalar decimal value.
 func (a Amount) MulScalar(b decimal.Decimal) Amount {
+	assertHasCurrency(a)
 	a.mag = a.mag.Mul(b)
 	return a
 }
@@ -55,10 +58,48 @@ func (a Amount) Div(b Amount) decimal.Decimal {
 //
 // To divide a by another Amount, use Div() instead.
 func (a Amount) DivScalar(b decimal.Decimal) Amount {
+	assertHasCurrency(a)
 	a.mag = a.mag.Div(b)
 	return a
 }
 
+// DivRem returns the quotient and remainder of a / b, where b is a scalar
+// decimal value.
+//
+// q is truncated towards zero at n decimal places, such that it can always be
+// represented exactly. r is the remainder, such that q * b + r is equal to a.
+// r has the same sign as a, and its magnitude is less than |b| × 10^-n.
+//
+// For example, dividing a USD amount of 10.00 by 3 with 2 decimal places
+// results in a quotient of 3.33 and a remainder of 0.01.
+//
+// It panics if b is zero.
+func (a Amount) DivRem(b decimal.Decimal, n int32) (q, r Amount) {
+	assertHasCurrency(a)
+
+	qm, rm := a.mag.QuoRem(b, n)
+
+	q, r = a, a
+	q.mag = qm
+	r.mag = rm
+
+	return q, r
+}
+
+// DivExact returns a / b with n decimal places, where b is a scalar decimal
+// value.
+//
+// It returns ErrInexact if the quotient can not be represented exactly with n
+// decimal places. It panics if b is zero.
+func (a Amount) DivExact(b decimal.Decimal, n int32) (Amount, error) {
+	q, r := a.DivRem(b, n)
+	if !r.IsZero() {
+		return Amount{}, ErrInexact
+	}
+
+	return q, nil
+}
+
 // Mod returns a % b.
 //
 // It panics if a and b do not use the same currency.
@@ -74,6 +115,7 @@ func (a Amount) Mod(b Amount) decimal.Decimal {
 //
 // To find the remainder of dividing by another Amount, use Mod() instead.
 func (a Amount) ModScalar(b decimal.Decimal) Amount {
+	assertHasCurrency(a)
 	a.mag = a.mag.Mod(b)
 	return a
 }
@@ -155,6 +155,50 @@ var _ = Describe("type Amount (math methods)", func() {
 			}).To(PanicWith("decimal division by 0"))
 		})
 	})
+
+	Describe("func DivRem()", func() {
+		DescribeTable(
+			"it returns the quotient and remainder",
+			func(a, b string, n int32, expectQ, expectR string) {
+				d := decimal.RequireFromString(b)
+				q, r := FromString("XYZ", a).DivRem(d, n)
+				Expect(q.EqualTo(FromString("XYZ", expectQ))).To(BeTrue(), "quotient is %s, expected %s", q.Magnitude(), expectQ)
+				Expect(r.EqualTo(FromString("XYZ", expectR))).To(BeTrue(), "remainder is %s, expected %s", r.Magnitude(), expectR)
+				Expect(q.MulScalar(d).Add(r).EqualTo(FromString("XYZ", a))).To(BeTrue())
+			},
+			Entry("exact", "10", "4", int32(2), "2.5", "0"),
+			Entry("non-terminating", "10", "3", int32(2), "3.33", "0.01"),
+			Entry("zero places", "10", "3", int32(0), "3", "1"),
+			Entry("negative dividend", "-10", "3", int32(2), "-3.33", "-0.01"),
+			Entry("negative divisor", "10", "-3", int32(2), "-3.33", "0.01"),
+			Entry("fractional divisor", "1", "0.3", int32(1), "3.3", "0.01"),
+		)
+
+		It("preserves the currency", func() {
+			q, r := FromString("XYZ", "10").DivRem(decimal.NewFromInt(3), 2)
+			Expect(q.CurrencyCode()).To(Equal("XYZ"))
+			Expect(r.CurrencyCode()).To(Equal("XYZ"))
+		})
+
+		It("panics when dividing by zero", func() {
+			Expect(func() {
+				FromString("XYZ", "10").DivRem(decimal.Decimal{}, 2)
+			}).To(PanicWith("decimal division by 0"))
+		})
+	})
+
+	Describe("func DivExact()", func() {
+		It("returns the quotient if it can be represented exactly", func() {
+			q, err := FromString("XYZ", "10").DivExact(decimal.NewFromInt(4), 2)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(q.EqualTo(FromString("XYZ", "2.5"))).To(BeTrue())
+		})
+
+		It("returns ErrInexact if the quotient can not be represented exactly", func() {
+			_, err := FromString("XYZ", "10").DivExact(decimal.NewFromInt(3), 2)
+			Expect(err).To(Equal(ErrInexact))
+		})
+	})
 })
 
 var _ = Describe("func Sum()", func() {
@@ -0,0 +1,174 @@
+package dosh
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// MathContext is an arithmetic context that bounds the precision of the
+// results of operations on amounts, and records whether any result had to be
+// rounded.
+//
+// It is similar in spirit to the contexts described by the IEEE 754 and
+// General Decimal Arithmetic specifications. Performing calculations via a
+// MathContext ensures that the scale of intermediate results does not grow
+// without limit, and that division does not depend on shopspring/decimal's
+// global DivisionPrecision variable.
+//
+// The zero-value is a context that rounds all results to integers using the
+// HalfUp rounding mode.
+//
+// A MathContext is not safe for concurrent use.
+type MathContext struct {
+	// MaxScale is the maximum number of decimal places in the result of any
+	// operation. Results with more decimal places are rounded to MaxScale
+	// places. It must not be negative.
+	MaxScale int32
+
+	// Mode is the rounding mode used when a result has more than MaxScale
+	// decimal places.
+	Mode RoundingMode
+
+	// TrapInexact, if true, causes operations to fail with ErrInexact instead
+	// of returning a rounded result.
+	TrapInexact bool
+
+	inexact bool
+}
+
+// Inexact returns true if the result of any operation performed within the
+// context has been rounded since the context was created or last reset.
+//
+// Operations that fail because TrapInexact is true, or because Mode is
+// Unnecessary, are also recorded as inexact.
+func (c *MathContext) Inexact() bool {
+	return c.inexact
+}
+
+// Reset clears the context's record of inexact operations.
+func (c *MathContext) Reset() {
+	c.inexact = false
+}
+
+// Apply returns a rounded to the context's precision.
+func (c *MathContext) Apply(a Amount) (Amount, error) {
+	assertHasCurrency(a)
+	return c.round(a)
+}
+
+// Add returns a + b, rounded to the context's precision.
+//
+// It panics if a and b do not use the same currency.
+func (c *MathContext) Add(a, b Amount) (Amount, error) {
+	return c.round(a.Add(b))
+}
+
+// Sub returns a - b, rounded to the context's precision.
+//
+// It panics if a and b do not use the same currency.
+func (c *MathContext) Sub(a, b Amount) (Amount, error) {
+	return c.round(a.Sub(b))
+}
+
+// MulScalar returns a * b, rounded to the context's precision, where b is a
+// scalar decimal value.
+func (c *MathContext) MulScalar(a Amount, b decimal.Decimal) (Amount, error) {
+	return c.round(a.MulScalar(b))
+}
+
+// DivScalar returns a / b, rounded to the context's precision, where b is a
+// scalar decimal value.
+//
+// Unlike Amount.DivScalar(), the result is rounded correctly according to the
+// context's rounding mode, regardless of shopspring/decimal's global
+// DivisionPrecision variable. It panics if b is zero.
+func (c *MathContext) DivScalar(a Amount, b decimal.Decimal) (Amount, error) {
+	assertHasCurrency(a)
+	c.assertValid()
+
+	q, exact, err := quoRem(a.mag, b, c.MaxScale, c.Mode)
+	if err := c.record(exact, err); err != nil {
+		return Amount{}, err
+	}
+
+	a.mag = q
+	return a, nil
+}
+
+// Sum returns the sum of the given amounts, rounded to the context's
+// precision.
+//
+// The amounts are summed exactly before rounding, such that the result is
+// rounded at most once. It panics if amounts is empty, or if the amounts do
+// not use the same currency.
+func (c *MathContext) Sum(amounts ...Amount) (Amount, error) {
+	return c.round(Sum(amounts...))
+}
+
+// Avg returns the mean of the given amounts, rounded to the context's
+// precision.
+//
+// It panics if amounts is empty, or if the amounts do not use the same
+// currency.
+func (c *MathContext) Avg(amounts ...Amount) (Amount, error) {
+	sum := Sum(amounts...)
+	n := decimal.NewFromInt(int64(len(amounts)))
+	return c.DivScalar(sum, n)
+}
+
+// round returns a rounded to the context's precision.
+func (c *MathContext) round(a Amount) (Amount, error) {
+	c.assertValid()
+
+	if -a.mag.Exponent() <= c.MaxScale {
+		return a, nil
+	}
+
+	// Truncation discards only trailing zeros if the magnitude can be
+	// represented exactly with MaxScale decimal places.
+	if t := a.mag.Truncate(c.MaxScale); t.Equal(a.mag) {
+		a.mag = t
+		return a, nil
+	}
+
+	m, err := roundDecimal(a.mag, c.MaxScale, c.Mode)
+	if err := c.record(false, err); err != nil {
+		return Amount{}, err
+	}
+
+	a.mag = m
+	return a, nil
+}
+
+// record records the outcome of an operation that may have been rounded.
+//
+// It returns a non-nil error if the operation failed, or if it was inexact and
+// TrapInexact is true.
+func (c *MathContext) record(exact bool, err error) error {
+	if err == ErrInexact {
+		c.inexact = true
+		return err
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if !exact {
+		c.inexact = true
+
+		if c.TrapInexact {
+			return ErrInexact
+		}
+	}
+
+	return nil
+}
+
+// assertValid panics if the context's configuration is invalid.
+func (c *MathContext) assertValid() {
+	if c.MaxScale < 0 {
+		panic(fmt.Sprintf("context's maximum scale (%d) must not be negative", c.MaxScale))
+	}
+}
@@ -0,0 +1,199 @@
+package dosh_test
+
+import (
+	. "github.com/dogmatiq/dosh"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/shopspring/decimal"
+)
+
+var _ = Describe("type MathContext", func() {
+	var ctx *MathContext
+
+	BeforeEach(func() {
+		ctx = &MathContext{
+			MaxScale: 2,
+			Mode:     HalfEven,
+		}
+	})
+
+	expectAmount := func(a Amount, err error, expect string) {
+		ExpectWithOffset(1, err).ShouldNot(HaveOccurred())
+		ExpectWithOffset(1, a.IdenticalTo(FromString("XYZ", expect))).To(
+			BeTrue(),
+			"got %s, expected %s",
+			a.Magnitude(),
+			expect,
+		)
+	}
+
+	Describe("func Add()", func() {
+		It("returns the sum without rounding if it is within the maximum scale", func() {
+			a, err := ctx.Add(FromString("XYZ", "1.25"), FromString("XYZ", "2.5"))
+			expectAmount(a, err, "3.75")
+			Expect(ctx.Inexact()).To(BeFalse())
+		})
+
+		It("rounds the sum if it exceeds the maximum scale", func() {
+			a, err := ctx.Add(FromString("XYZ", "1.125"), FromString("XYZ", "2"))
+			expectAmount(a, err, "3.12")
+			Expect(ctx.Inexact()).To(BeTrue())
+		})
+
+		It("discards trailing zeros beyond the maximum scale without recording an inexact result", func() {
+			a, err := ctx.Add(FromString("XYZ", "1.1000"), FromString("XYZ", "2"))
+			expectAmount(a, err, "3.10")
+			Expect(ctx.Inexact()).To(BeFalse())
+		})
+	})
+
+	Describe("func Sub()", func() {
+		It("rounds the difference if it exceeds the maximum scale", func() {
+			a, err := ctx.Sub(FromString("XYZ", "1"), FromString("XYZ", "0.125"))
+			expectAmount(a, err, "0.88")
+			Expect(ctx.Inexact()).To(BeTrue())
+		})
+	})
+
+	Describe("func MulScalar()", func() {
+		It("bounds the scale of repeated multiplication", func() {
+			a := FromString("XYZ", "100")
+			r := decimal.RequireFromString("1.0375")
+
+			for i := 0; i < 10; i++ {
+				var err error
+				a, err = ctx.MulScalar(a, r)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(a.Magnitude().Exponent()).To(BeNumerically(">=", -2))
+			}
+
+			Expect(ctx.Inexact()).To(BeTrue())
+		})
+	})
+
+	Describe("func DivScalar()", func() {
+		DescribeTable(
+			"it returns the quotient rounded using the context's rounding mode",
+			func(mode RoundingMode, a, b, expect string) {
+				ctx.Mode = mode
+				q, err := ctx.DivScalar(FromString("XYZ", a), decimal.RequireFromString(b))
+				expectAmount(q, err, expect)
+			},
+			Entry("exact", HalfEven, "10", "4", "2.5"),
+			Entry("non-terminating, HalfEven", HalfEven, "10", "3", "3.33"),
+			Entry("non-terminating, Ceiling", Ceiling, "10", "3", "3.34"),
+			Entry("non-terminating, negative Floor", Floor, "-10", "3", "-3.34"),
+			Entry("negative divisor, Up", Up, "10", "-3", "-3.34"),
+			Entry("half, HalfEven", HalfEven, "0.125", "1", "0.12"),
+			Entry("half, HalfUp", HalfUp, "0.125", "1", "0.13"),
+			Entry("half, HalfDown", HalfDown, "-0.125", "1", "-0.12"),
+			Entry("half via division, HalfOdd", HalfOdd, "1.25", "10", "0.13"),
+		)
+
+		It("does not depend on shopspring/decimal's DivisionPrecision", func() {
+			prev := decimal.DivisionPrecision
+			decimal.DivisionPrecision = 1
+			defer func() { decimal.DivisionPrecision = prev }()
+
+			ctx.MaxScale = 4
+			q, err := ctx.DivScalar(FromString("XYZ", "2"), decimal.RequireFromString("3"))
+			expectAmount(q, err, "0.6667")
+		})
+
+		It("records an inexact result", func() {
+			_, err := ctx.DivScalar(FromString("XYZ", "10"), decimal.RequireFromString("4"))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(ctx.Inexact()).To(BeFalse())
+
+			_, err = ctx.DivScalar(FromString("XYZ", "10"), decimal.RequireFromString("3"))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(ctx.Inexact()).To(BeTrue())
+		})
+
+		It("panics if the divisor is zero", func() {
+			Expect(func() {
+				ctx.DivScalar(FromString("XYZ", "10"), decimal.Zero)
+			}).To(Panic())
+		})
+	})
+
+	Describe("func Sum()", func() {
+		It("rounds the sum only once", func() {
+			a, err := ctx.Sum(
+				FromString("XYZ", "0.004"),
+				FromString("XYZ", "0.004"),
+			)
+			expectAmount(a, err, "0.01")
+		})
+	})
+
+	Describe("func Avg()", func() {
+		It("returns the rounded mean", func() {
+			a, err := ctx.Avg(
+				FromString("XYZ", "1"),
+				FromString("XYZ", "1"),
+				FromString("XYZ", "2"),
+			)
+			expectAmount(a, err, "1.33")
+			Expect(ctx.Inexact()).To(BeTrue())
+		})
+	})
+
+	Describe("func Apply()", func() {
+		It("rounds the amount to the context's precision", func() {
+			a, err := ctx.Apply(FromString("XYZ", "1.005"))
+			expectAmount(a, err, "1.00")
+		})
+	})
+
+	Describe("func Reset()", func() {
+		It("clears the record of inexact operations", func() {
+			_, err := ctx.Apply(FromString("XYZ", "1.005"))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(ctx.Inexact()).To(BeTrue())
+
+			ctx.Reset()
+			Expect(ctx.Inexact()).To(BeFalse())
+		})
+	})
+
+	When("TrapInexact is true", func() {
+		BeforeEach(func() {
+			ctx.TrapInexact = true
+		})
+
+		It("returns ErrInexact instead of rounding", func() {
+			_, err := ctx.DivScalar(FromString("XYZ", "10"), decimal.RequireFromString("3"))
+			Expect(err).To(Equal(ErrInexact))
+			Expect(ctx.Inexact()).To(BeTrue())
+		})
+
+		It("does not return an error for exact results", func() {
+			a, err := ctx.MulScalar(FromString("XYZ", "1.5"), decimal.RequireFromString("1.5"))
+			expectAmount(a, err, "2.25")
+		})
+	})
+
+	When("the rounding mode is Unnecessary", func() {
+		It("returns ErrInexact instead of rounding", func() {
+			ctx.Mode = Unnecessary
+			_, err := ctx.Add(FromString("XYZ", "1.125"), FromString("XYZ", "2"))
+			Expect(err).To(Equal(ErrInexact))
+			Expect(ctx.Inexact()).To(BeTrue())
+		})
+	})
+
+	It("uses integer precision when it is the zero-value", func() {
+		var ctx MathContext
+		a, err := ctx.DivScalar(FromString("XYZ", "5"), decimal.RequireFromString("2"))
+		expectAmount(a, err, "3")
+	})
+
+	It("panics if the maximum scale is negative", func() {
+		ctx.MaxScale = -1
+		Expect(func() {
+			ctx.Apply(FromString("XYZ", "1"))
+		}).To(PanicWith("context's maximum scale (-1) must not be negative"))
+	})
+})
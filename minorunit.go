@@ -0,0 +1,95 @@
+package dosh
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/dogmatiq/dosh/currency"
+)
+
+// RoundToMinorUnit returns the amount rounded to the precision of its
+// currency's minor unit, using the given rounding mode.
+//
+// For example, a "USD" amount is rounded to whole cents (2 decimal places),
+// whereas a "JPY" amount is rounded to whole yen (0 decimal places).
+//
+// It returns an error if the currency is not known, or if the concept of a
+// minor unit is not applicable to the currency, such as for precious metals.
+// It returns ErrInexact if mode is Unnecessary and the amount can not be
+// represented exactly in the currency's minor unit.
+func (a Amount) RoundToMinorUnit(mode RoundingMode) (Amount, error) {
+	n, err := minorUnits(a)
+	if err != nil {
+		return Amount{}, err
+	}
+
+	return a.RoundWith(n, mode)
+}
+
+// IsMinorUnitExact returns true if the amount can be represented exactly in
+// its currency's minor unit, such as a "USD" amount that is a whole number of
+// cents.
+//
+// It returns false if the currency is not known, or if the concept of a minor
+// unit is not applicable to the currency.
+func (a Amount) IsMinorUnitExact() bool {
+	n, err := minorUnits(a)
+	if err != nil {
+		return false
+	}
+
+	return a.mag.Equal(a.mag.Truncate(n))
+}
+
+// SumRounded returns the sum of the given amounts, rounded to the precision of
+// their currency's minor unit using the given rounding mode.
+//
+// The amounts are summed exactly before rounding, such that the result is
+// rounded only once.
+//
+// It panics if amounts is empty, or if the amounts do not use the same
+// currency. It returns an error under the same conditions as
+// Amount.RoundToMinorUnit().
+func SumRounded(mode RoundingMode, amounts ...Amount) (Amount, error) {
+	return Sum(amounts...).RoundToMinorUnit(mode)
+}
+
+// minorUnits returns the number of decimal places used by the minor unit of
+// a's currency.
+func minorUnits(a Amount) (int32, error) {
+	n, err := minorUnitsOf(a.CurrencyCode())
+	if err != nil {
+		return 0, fmt.Errorf("cannot round %s to its minor unit: %w", describe(a), err)
+	}
+
+	return n, nil
+}
+
+// minorUnitsOf returns the number of decimal places used by the minor unit of
+// the currency c.
+func minorUnitsOf(c string) (int32, error) {
+	if c == "" {
+		return 0, ErrNoCurrency
+	}
+
+	i, ok := currency.Lookup(c)
+	if !ok {
+		return 0, errors.New("currency is not known")
+	}
+
+	if !i.HasMinorUnits() {
+		return 0, errors.New("currency has no minor unit")
+	}
+
+	return int32(i.MinorUnits), nil
+}
+
+// describe returns a description of a for use in error messages, such as
+// "USD amount", or simply "amount" if a has no currency.
+func describe(a Amount) string {
+	if c := a.CurrencyCode(); c != "" {
+		return c + " amount"
+	}
+
+	return "amount"
+}
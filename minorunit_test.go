@@ -0,0 +1,96 @@
+package dosh_test
+
+import (
+	. "github.com/dogmatiq/dosh"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("type Amount (minor unit methods)", func() {
+	Describe("func RoundToMinorUnit()", func() {
+		DescribeTable(
+			"it returns an amount rounded to the precision of the currency's minor unit",
+			func(c, a, expect string) {
+				r, err := FromString(c, a).RoundToMinorUnit(HalfEven)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(r.IdenticalTo(FromString(c, expect))).To(
+					BeTrue(),
+					"%s rounded to %s, expected %s",
+					a,
+					r.Magnitude(),
+					expect,
+				)
+			},
+			Entry("USD (2 places)", "USD", "1.125", "1.12"),
+			Entry("JPY (0 places)", "JPY", "1234.5", "1234"),
+			Entry("BHD (3 places)", "BHD", "1.23456", "1.235"),
+			Entry("already exact", "USD", "1.5", "1.5"),
+		)
+
+		It("returns ErrInexact if the mode is Unnecessary and rounding is required", func() {
+			_, err := FromString("JPY", "1.5").RoundToMinorUnit(Unnecessary)
+			Expect(err).To(Equal(ErrInexact))
+		})
+
+		It("returns an error if the currency is not known", func() {
+			_, err := FromString("XYZ", "1.5").RoundToMinorUnit(HalfUp)
+			Expect(err).To(MatchError("cannot round XYZ amount to its minor unit: currency is not known"))
+		})
+
+		It("returns an error if the currency has no minor unit", func() {
+			_, err := FromString("XAU", "1.5").RoundToMinorUnit(HalfUp)
+			Expect(err).To(MatchError("cannot round XAU amount to its minor unit: currency has no minor unit"))
+		})
+	})
+
+	Describe("func IsMinorUnitExact()", func() {
+		DescribeTable(
+			"it returns true if the amount can be represented exactly in the currency's minor unit",
+			func(c, a string, expect bool) {
+				Expect(FromString(c, a).IsMinorUnitExact()).To(Equal(expect))
+			},
+			Entry("USD, whole cents", "USD", "1.23", true),
+			Entry("USD, whole cents with trailing zeros", "USD", "1.2300", true),
+			Entry("USD, fractional cents", "USD", "1.235", false),
+			Entry("JPY, whole yen", "JPY", "-100", true),
+			Entry("JPY, fractional yen", "JPY", "100.5", false),
+			Entry("unknown currency", "XYZ", "1", false),
+			Entry("currency with no minor unit", "XAU", "1", false),
+		)
+	})
+})
+
+var _ = Describe("func SumRounded()", func() {
+	It("returns the sum of the amounts rounded to the currency's minor unit", func() {
+		r, err := SumRounded(
+			HalfUp,
+			FromString("USD", "0.333"),
+			FromString("USD", "0.333"),
+			FromString("USD", "0.334"),
+		)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(r.IdenticalTo(FromString("USD", "1.00"))).To(BeTrue())
+	})
+
+	It("rounds only once", func() {
+		r, err := SumRounded(
+			HalfUp,
+			FromString("JPY", "0.4"),
+			FromString("JPY", "0.4"),
+		)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(r.EqualTo(FromString("JPY", "1"))).To(BeTrue())
+	})
+
+	It("returns an error if the currency has no minor unit", func() {
+		_, err := SumRounded(HalfUp, FromString("XAU", "1"))
+		Expect(err).To(MatchError("cannot round XAU amount to its minor unit: currency has no minor unit"))
+	})
+
+	It("panics if no amounts are provided", func() {
+		Expect(func() {
+			SumRounded(HalfUp)
+		}).To(PanicWith("at least one amount must be provided"))
+	})
+})
@@ -0,0 +1,122 @@
+package dosh_test
+
+import (
+	. "github.com/dogmatiq/dosh"
+	"github.com/dogmatiq/dosh/currency"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/shopspring/decimal"
+	"google.golang.org/genproto/googleapis/type/money"
+)
+
+var _ = Describe("type Amount (currency policy)", func() {
+	const expect = "currency code (XYZ) is invalid, it is not an ISO 4217 currency code"
+
+	BeforeEach(func() {
+		currency.SetPolicy(currency.ISOActive)
+	})
+
+	AfterEach(func() {
+		currency.SetPolicy(currency.Lenient)
+	})
+
+	It("accepts currency codes that are valid under the current policy", func() {
+		a := FromInt("USD", 1)
+		Expect(a.CurrencyCode()).To(Equal("USD"))
+	})
+
+	It("rejects the testing currency unless test mode is enabled", func() {
+		Expect(func() {
+			Zero("XTS")
+		}).To(PanicWith(MatchError("currency code (XTS) is invalid, it is reserved for testing and test mode is disabled")))
+
+		currency.SetTestMode(true)
+		defer currency.SetTestMode(false)
+
+		Expect(Zero("XTS").CurrencyCode()).To(Equal("XTS"))
+	})
+
+	It("is honoured by FromDecimal()", func() {
+		Expect(func() {
+			FromDecimal("XYZ", decimal.Decimal{})
+		}).To(PanicWith(MatchError(expect)))
+	})
+
+	It("is honoured by UnmarshalText()", func() {
+		var a Amount
+		err := a.UnmarshalText([]byte("XYZ 1.23"))
+		Expect(err).To(MatchError("cannot unmarshal amount from text representation: " + expect))
+	})
+
+	It("is honoured by UnmarshalBinary()", func() {
+		var a Amount
+		err := a.UnmarshalBinary([]byte{3, 'X', 'Y', 'Z'})
+		Expect(err).To(MatchError("cannot unmarshal amount from binary representation: " + expect))
+	})
+
+	It("is honoured by UnmarshalJSON()", func() {
+		var a Amount
+		err := a.UnmarshalJSON([]byte(`{"currency_code":"XYZ","units":"10"}`))
+		Expect(err).To(MatchError("cannot unmarshal amount from JSON representation: " + expect))
+	})
+
+	It("is honoured by UnmarshalProto()", func() {
+		var a Amount
+		err := a.UnmarshalProto(&money.Money{CurrencyCode: "XYZ"})
+		Expect(err).To(MatchError("cannot unmarshal amount from protocol buffers representation: " + expect))
+	})
+})
+
+var _ = Describe("type Amount (custom currencies)", func() {
+	BeforeEach(func() {
+		// Registration is global, so only attempt it once.
+		if _, ok := currency.Lookup("1INCH"); !ok {
+			err := currency.Register(currency.Info{Code: "1INCH", MinorUnits: 18})
+			Expect(err).ShouldNot(HaveOccurred())
+		}
+	})
+
+	It("can be constructed with a custom currency code", func() {
+		a := FromString("1INCH", "1.5")
+		Expect(a.CurrencyCode()).To(Equal("1INCH"))
+	})
+
+	It("can be marshaled and unmarshaled using the text representation", func() {
+		data, err := FromString("1INCH", "1.5").MarshalText()
+		Expect(err).ShouldNot(HaveOccurred())
+
+		var a Amount
+		err = a.UnmarshalText(data)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(a.IdenticalTo(FromString("1INCH", "1.5"))).To(BeTrue())
+	})
+
+	It("can be marshaled and unmarshaled using the binary representation", func() {
+		data, err := FromString("1INCH", "1.5").MarshalBinary()
+		Expect(err).ShouldNot(HaveOccurred())
+
+		var a Amount
+		err = a.UnmarshalBinary(data)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(a.IdenticalTo(FromString("1INCH", "1.5"))).To(BeTrue())
+	})
+
+	It("can be marshaled and unmarshaled using the JSON representation", func() {
+		data, err := FromString("1INCH", "1.5").MarshalJSON()
+		Expect(err).ShouldNot(HaveOccurred())
+
+		var a Amount
+		err = a.UnmarshalJSON(data)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(a.IdenticalTo(FromString("1INCH", "1.5"))).To(BeTrue())
+	})
+
+	It("is accepted by strict policies", func() {
+		currency.SetPolicy(currency.ISOActive)
+		defer currency.SetPolicy(currency.Lenient)
+
+		var a Amount
+		err := a.UnmarshalProto(&money.Money{CurrencyCode: "1INCH", Units: 1})
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+})
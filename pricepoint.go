@@ -0,0 +1,124 @@
+package dosh
+
+import (
+	"fmt"
+
+	"github.com/dogmatiq/dosh/internal/round"
+	"github.com/dogmatiq/dosh/rounding"
+	"github.com/shopspring/decimal"
+)
+
+// PricePoint is a rule that describes a set of "psychological" prices, such as
+// those ending in .99.
+//
+// It is an alias for rounding.PricePoint, such that the same rules can be used
+// with both Amount and the protomoney package.
+type PricePoint = rounding.PricePoint
+
+// RoundToPricePoint returns the amount rounded to a price described by one of
+// the given price point rules.
+//
+// Each rule is used to round the amount using the given rounding mode, and the
+// result that is nearest to the original amount is returned. If several rules
+// produce results that are equally near, the earliest rule takes precedence.
+// The mode therefore determines the direction of rounding; for example, the
+// Ceiling mode always produces a result greater than or equal to the original
+// amount, whereas HalfUp produces the nearest price.
+//
+// It returns ErrInexact if mode is Unnecessary and the amount is not already
+// one of the described prices. It returns an error if no price satisfies the
+// rounding mode, such as when rounding an amount of 0.50 down to a price ending
+// in .99.
+//
+// It panics if no rules are given, or if any of the rules are invalid.
+func (a Amount) RoundToPricePoint(mode RoundingMode, points ...PricePoint) (Amount, error) {
+	assertHasCurrency(a)
+
+	if len(points) == 0 {
+		panic("at least one price point must be provided")
+	}
+
+	neg := a.mag.IsNegative()
+	mag := a.mag.Abs()
+
+	// Rounding is performed on the magnitude, so the direction of any
+	// directional rounding mode must be reversed for negative amounts.
+	m := mode
+	if neg {
+		m = round.Mirror(mode)
+	}
+
+	var (
+		best  decimal.Decimal
+		dist  decimal.Decimal
+		found bool
+	)
+
+	for _, p := range points {
+		if err := p.Validate(); err != nil {
+			panic(err)
+		}
+
+		c, ok, err := roundToPricePoint(mag, p, m)
+		if err != nil {
+			if err == ErrInexact {
+				continue
+			}
+			return Amount{}, err
+		}
+
+		if !ok {
+			continue
+		}
+
+		d := c.Sub(mag).Abs()
+		if !found || d.LessThan(dist) {
+			best, dist, found = c, d, true
+		}
+	}
+
+	if !found {
+		if mode == Unnecessary {
+			return Amount{}, ErrInexact
+		}
+
+		return Amount{}, fmt.Errorf(
+			"cannot round %s amount to a price point: no price point satisfies the %s rounding mode",
+			a.CurrencyCode(),
+			mode,
+		)
+	}
+
+	if neg {
+		best = best.Neg()
+	}
+
+	a.mag = best
+	return a, nil
+}
+
+// roundToPricePoint returns the non-negative magnitude m rounded to a price
+// described by p using the given rounding mode.
+//
+// ok is false if there is no price described by p that satisfies the rounding
+// mode.
+func roundToPricePoint(m decimal.Decimal, p PricePoint, mode RoundingMode) (_ decimal.Decimal, ok bool, _ error) {
+	if m.LessThan(p.Ending) {
+		// The magnitude is below the lowest price described by p, which is
+		// p.Ending itself. Rounding up to p.Ending is only acceptable if the
+		// mode permits rounding away from zero.
+		switch mode {
+		case Down, Floor, Unnecessary:
+			return decimal.Decimal{}, false, nil
+		}
+
+		return p.Ending, true, nil
+	}
+
+	x, err := roundToIncrement(m.Sub(p.Ending), p.Step, mode)
+	if err != nil {
+		return decimal.Decimal{}, false, err
+	}
+
+	return x.Add(p.Ending), true, nil
+}
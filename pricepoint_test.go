@@ -0,0 +1,95 @@
+package dosh_test
+
+import (
+	. "github.com/dogmatiq/dosh"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/shopspring/decimal"
+)
+
+var _ = Describe("type Amount (price point methods)", func() {
+	Describe("func RoundToPricePoint()", func() {
+		var (
+			ninetyNine = PricePoint{
+				Step:   decimal.NewFromInt(1),
+				Ending: decimal.RequireFromString("0.99"),
+			}
+			ninetyFive = PricePoint{
+				Step:   decimal.NewFromInt(1),
+				Ending: decimal.RequireFromString("0.95"),
+			}
+			endsInNine = PricePoint{
+				Step:   decimal.NewFromInt(10),
+				Ending: decimal.NewFromInt(9),
+			}
+		)
+
+		DescribeTable(
+			"it returns the amount rounded to a price point",
+			func(mode RoundingMode, a, expect string, points ...PricePoint) {
+				r, err := FromString("XYZ", a).RoundToPricePoint(mode, points...)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(r.EqualTo(FromString("XYZ", expect))).To(
+					BeTrue(),
+					"%s rounded to %s, expected %s",
+					a,
+					r.Magnitude(),
+					expect,
+				)
+			},
+			Entry(".99, nearest below", HalfUp, "12.30", "11.99", ninetyNine),
+			Entry(".99, nearest above", HalfUp, "12.70", "12.99", ninetyNine),
+			Entry(".99, ceiling", Ceiling, "12.30", "12.99", ninetyNine),
+			Entry(".99, floor", Floor, "12.98", "11.99", ninetyNine),
+			Entry(".99, down", Down, "12.98", "11.99", ninetyNine),
+			Entry(".99, already a price point", Unnecessary, "12.99", "12.99", ninetyNine),
+			Entry(".99, below the lowest price point", HalfUp, "0.10", "0.99", ninetyNine),
+			Entry("x9.00, nearest", HalfUp, "123.45", "119", endsInNine),
+			Entry("x9.00, ceiling", Ceiling, "123.45", "129", endsInNine),
+			Entry("nearest of several rules", HalfUp, "12.96", "12.95", ninetyNine, ninetyFive),
+			Entry("ceiling across several rules", Ceiling, "12.96", "12.99", ninetyNine, ninetyFive),
+			Entry("floor across several rules", Floor, "12.98", "12.95", ninetyNine, ninetyFive),
+			Entry("equally near, earlier rule takes precedence", HalfUp, "12.97", "12.99", ninetyNine, ninetyFive),
+			Entry("negative, nearest", HalfUp, "-12.30", "-11.99", ninetyNine),
+			Entry("negative, ceiling", Ceiling, "-12.30", "-11.99", ninetyNine),
+			Entry("negative, floor", Floor, "-12.30", "-12.99", ninetyNine),
+			Entry("negative, down", Down, "-12.30", "-11.99", ninetyNine),
+		)
+
+		It("returns ErrInexact if the mode is Unnecessary and the amount is not a price point", func() {
+			_, err := FromString("XYZ", "12.50").RoundToPricePoint(Unnecessary, ninetyNine, ninetyFive)
+			Expect(err).To(Equal(ErrInexact))
+		})
+
+		DescribeTable(
+			"it returns an error if no price point satisfies the rounding mode",
+			func(mode RoundingMode, a, expect string) {
+				_, err := FromString("XYZ", a).RoundToPricePoint(mode, ninetyNine)
+				Expect(err).To(MatchError(expect))
+			},
+			Entry("floor", Floor, "0.50", "cannot round XYZ amount to a price point: no price point satisfies the Floor rounding mode"),
+			Entry("down", Down, "0.50", "cannot round XYZ amount to a price point: no price point satisfies the Down rounding mode"),
+			Entry("negative, ceiling", Ceiling, "-0.50", "cannot round XYZ amount to a price point: no price point satisfies the Ceiling rounding mode"),
+			Entry("negative, down", Down, "-0.50", "cannot round XYZ amount to a price point: no price point satisfies the Down rounding mode"),
+		)
+
+		It("panics if no price points are provided", func() {
+			Expect(func() {
+				FromString("XYZ", "1").RoundToPricePoint(HalfUp)
+			}).To(PanicWith("at least one price point must be provided"))
+		})
+
+		It("panics if the ending is not less than the step", func() {
+			Expect(func() {
+				FromString("XYZ", "1").RoundToPricePoint(
+					HalfUp,
+					PricePoint{
+						Step:   decimal.NewFromInt(1),
+						Ending: decimal.NewFromInt(1),
+					},
+				)
+			}).To(PanicWith(MatchError("price point ending (1) must be non-negative and less than the step (1)")))
+		})
+	})
+})
@@ -0,0 +1,226 @@
+package protomoney
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+	"sort"
+
+	"google.golang.org/genproto/googleapis/type/money"
+)
+
+// Allocate divides m into parts in proportion to the given ratios, such that
+// the parts add up to exactly m.
+//
+// Each part is a whole multiple of 10^-places; for example, a whole number of
+// cents if places is 2. The amount is allocated using the largest remainder
+// method; that is, each part is first rounded towards zero, and any leftover
+// increments are distributed one at a time to the parts that lost the most to
+// rounding. If several parts lost an equal amount, the part with the lowest
+// index is preferred.
+//
+// Ratios are relative to each other; they do not need to add up to 1 or 100.
+// A negative amount is allocated as though it were positive, and then each of
+// the parts is negated.
+//
+// The calculation is performed using integer arithmetic on the units and nanos
+// components, without conversion to another data type.
+//
+// It returns an error if m has more than the given number of decimal places,
+// if the signs of its components do not agree, if any ratio is negative, or
+// if all ratios are zero. It panics if no ratios are provided, or if places is
+// not between 0 and 9.
+func Allocate(m *money.Money, places int32, ratios ...int64) ([]*money.Money, error) {
+	if len(ratios) == 0 {
+		panic("at least one ratio must be provided")
+	}
+
+	weights, err := ratioWeights(ratios)
+	if err != nil {
+		return nil, fmt.Errorf("cannot allocate amount: %w", err)
+	}
+
+	parts, err := allocate(m, places, weights)
+	if err != nil {
+		return nil, fmt.Errorf("cannot allocate amount: %w", err)
+	}
+
+	return parts, nil
+}
+
+// Split divides m into n parts of equal size, such that the parts add up to
+// exactly m.
+//
+// Each part is a whole multiple of 10^-places. Any increments left over after
+// dividing the amount evenly are distributed one at a time to the first parts.
+// A negative amount is split as though it were positive, and then each of the
+// parts is negated.
+//
+// It returns an error if m has more than the given number of decimal places,
+// if the signs of its components do not agree, or if m is non-zero but
+// smaller than n increments, such that a part would be less than a single
+// increment. It panics if n is not positive, or if places is not between 0
+// and 9.
+func Split(m *money.Money, places int32, n int) ([]*money.Money, error) {
+	if n <= 0 {
+		panic(fmt.Sprintf("number of parts (%d) must be positive", n))
+	}
+
+	weights := make([]uint64, n)
+	for i := range weights {
+		weights[i] = 1
+	}
+
+	parts, err := allocate(m, places, weights)
+	if err != nil {
+		return nil, fmt.Errorf("cannot split amount into %d parts: %w", n, err)
+	}
+
+	// The leftover increments are given to the first parts, so the last part
+	// is the smallest. It is only zero if the amount itself is non-zero but
+	// too small to give each part at least one increment.
+	last := parts[n-1]
+	if last.Units == 0 && last.Nanos == 0 && !IsZero(m) {
+		return nil, fmt.Errorf(
+			"cannot split amount into %d parts: each part would be less than the smallest increment",
+			n,
+		)
+	}
+
+	return parts, nil
+}
+
+// ratioWeights returns the given ratios as unsigned weights.
+func ratioWeights(ratios []int64) ([]uint64, error) {
+	weights := make([]uint64, len(ratios))
+
+	var sum uint64
+	for i, r := range ratios {
+		if r < 0 {
+			return nil, fmt.Errorf("ratio (%d) is negative", r)
+		}
+
+		var carry uint64
+		weights[i] = uint64(r)
+		sum, carry = bits.Add64(sum, weights[i], 0)
+		if carry != 0 {
+			return nil, errors.New("sum of the ratios overflows")
+		}
+	}
+
+	if sum == 0 {
+		return nil, errors.New("all ratios are zero")
+	}
+
+	return weights, nil
+}
+
+// allocate divides m into parts in proportion to the given weights, which
+// must not all be zero and must not add up to more than the maximum uint64.
+func allocate(m *money.Money, places int32, weights []uint64) ([]*money.Money, error) {
+	if places < 0 || places > 9 {
+		panic(fmt.Sprintf("decimal places (%d) must be between 0 and 9", places))
+	}
+
+	if err := checkSignsAgree(m); err != nil {
+		return nil, err
+	}
+
+	units, nanos := normalizeComponents(m)
+
+	// step is the number of nanos in each increment.
+	step := pow10(9 - places)
+	if int64(nanos)%step != 0 {
+		return nil, fmt.Errorf("amount has more than %d decimal places", places)
+	}
+
+	neg := units < 0 || nanos < 0
+
+	// The magnitude of the amount is u units plus q increments, where each
+	// unit consists of perUnit increments. The conversion to uint64 produces
+	// the correct magnitude even for the minimum int64.
+	var (
+		u       = uint64(units)
+		q       = uint64(nanos / int32(step))
+		perUnit = uint64(pow10(places))
+	)
+
+	if neg {
+		u, q = -u, -q
+	}
+
+	var sum uint64
+	for _, w := range weights {
+		sum += w
+	}
+
+	var (
+		partUnits = make([]uint64, len(weights))
+		partIncs  = make([]uint64, len(weights))
+		rems      = make([]uint64, len(weights))
+		remHi     uint64
+		remLo     uint64
+	)
+
+	for i, w := range weights {
+		// The share of the units component: u × w = sum × a + b. Because w <=
+		// sum, a can not exceed u.
+		hi, lo := bits.Mul64(u, w)
+		a, b := bits.Div64(hi, lo, sum)
+
+		// The share of the remaining b units, along with the increments:
+		// (b × perUnit + q × w) / sum. Each term divided by sum is less than
+		// perUnit, so the quotient is less than 2 × perUnit.
+		h1, l1 := bits.Mul64(b, perUnit)
+		h2, l2 := bits.Mul64(q, w)
+		l, carry := bits.Add64(l1, l2, 0)
+		h, _ := bits.Add64(h1, h2, carry)
+		c, r := bits.Div64(h, l, sum)
+
+		partUnits[i] = a + c/perUnit
+		partIncs[i] = c % perUnit
+		rems[i] = r
+
+		remLo, carry = bits.Add64(remLo, r, 0)
+		remHi += carry
+	}
+
+	// The sum of the remainders is equal to the number of leftover increments
+	// multiplied by sum.
+	left, _ := bits.Div64(remHi, remLo, sum)
+
+	order := make([]int, len(weights))
+	for i := range order {
+		order[i] = i
+	}
+
+	sort.SliceStable(order, func(i, j int) bool {
+		return rems[order[i]] > rems[order[j]]
+	})
+
+	for _, i := range order[:left] {
+		partIncs[i]++
+		if partIncs[i] == perUnit {
+			partUnits[i]++
+			partIncs[i] = 0
+		}
+	}
+
+	parts := make([]*money.Money, len(weights))
+	for i := range parts {
+		p := &money.Money{
+			CurrencyCode: m.CurrencyCode,
+			Units:        int64(partUnits[i]),
+			Nanos:        int32(partIncs[i]) * int32(step),
+		}
+
+		if neg {
+			p.Units = -p.Units
+			p.Nanos = -p.Nanos
+		}
+
+		parts[i] = p
+	}
+
+	return parts, nil
+}
@@ -0,0 +1,201 @@
+package protomoney_test
+
+import (
+	"math"
+
+	. "github.com/dogmatiq/dosh/protomoney"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"google.golang.org/genproto/googleapis/type/money"
+)
+
+var _ = Describe("func Allocate()", func() {
+	DescribeTable(
+		"it allocates the amount in proportion to the ratios",
+		func(m *money.Money, places int32, ratios []int64, expect []*money.Money) {
+			parts, err := Allocate(m, places, ratios...)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(parts).To(Equal(expect))
+		},
+		Entry(
+			"even allocation",
+			&money.Money{CurrencyCode: "XYZ", Units: 10},
+			int32(2),
+			[]int64{1, 1},
+			[]*money.Money{
+				{CurrencyCode: "XYZ", Units: 5},
+				{CurrencyCode: "XYZ", Units: 5},
+			},
+		),
+		Entry(
+			"leftover increments go to the largest remainders",
+			&money.Money{CurrencyCode: "XYZ", Nanos: 50000000},
+			int32(2),
+			[]int64{3, 7},
+			[]*money.Money{
+				{CurrencyCode: "XYZ", Nanos: 20000000},
+				{CurrencyCode: "XYZ", Nanos: 30000000},
+			},
+		),
+		Entry(
+			"ties are broken by lowest index",
+			&money.Money{CurrencyCode: "XYZ", Units: 100},
+			int32(2),
+			[]int64{1, 1, 1},
+			[]*money.Money{
+				{CurrencyCode: "XYZ", Units: 33, Nanos: 340000000},
+				{CurrencyCode: "XYZ", Units: 33, Nanos: 330000000},
+				{CurrencyCode: "XYZ", Units: 33, Nanos: 330000000},
+			},
+		),
+		Entry(
+			"zero ratios",
+			&money.Money{CurrencyCode: "XYZ", Units: 1},
+			int32(2),
+			[]int64{0, 1, 0},
+			[]*money.Money{
+				{CurrencyCode: "XYZ"},
+				{CurrencyCode: "XYZ", Units: 1},
+				{CurrencyCode: "XYZ"},
+			},
+		),
+		Entry(
+			"negative amount",
+			&money.Money{CurrencyCode: "XYZ", Units: -1},
+			int32(2),
+			[]int64{1, 2},
+			[]*money.Money{
+				{CurrencyCode: "XYZ", Nanos: -330000000},
+				{CurrencyCode: "XYZ", Nanos: -670000000},
+			},
+		),
+		Entry(
+			"whole units",
+			&money.Money{CurrencyCode: "XYZ", Units: 10},
+			int32(0),
+			[]int64{1, 1, 1},
+			[]*money.Money{
+				{CurrencyCode: "XYZ", Units: 4},
+				{CurrencyCode: "XYZ", Units: 3},
+				{CurrencyCode: "XYZ", Units: 3},
+			},
+		),
+		Entry(
+			"nanos",
+			&money.Money{CurrencyCode: "XYZ", Units: 1},
+			int32(9),
+			[]int64{1, 2},
+			[]*money.Money{
+				{CurrencyCode: "XYZ", Nanos: 333333333},
+				{CurrencyCode: "XYZ", Nanos: 666666667},
+			},
+		),
+		Entry(
+			"large amounts and ratios",
+			&money.Money{CurrencyCode: "XYZ", Units: math.MaxInt64, Nanos: 999999999},
+			int32(9),
+			[]int64{math.MaxInt64, math.MaxInt64},
+			[]*money.Money{
+				{CurrencyCode: "XYZ", Units: 4611686018427387904},
+				{CurrencyCode: "XYZ", Units: 4611686018427387903, Nanos: 999999999},
+			},
+		),
+		Entry(
+			"the minimum amount",
+			&money.Money{CurrencyCode: "XYZ", Units: math.MinInt64},
+			int32(0),
+			[]int64{1, 1},
+			[]*money.Money{
+				{CurrencyCode: "XYZ", Units: math.MinInt64 / 2},
+				{CurrencyCode: "XYZ", Units: math.MinInt64 / 2},
+			},
+		),
+	)
+
+	It("returns an error if the amount has more decimal places than requested", func() {
+		m := &money.Money{CurrencyCode: "XYZ", Nanos: 1000000}
+		_, err := Allocate(m, 2, 1, 1)
+		Expect(err).To(MatchError("cannot allocate amount: amount has more than 2 decimal places"))
+	})
+
+	It("returns an error if the signs of the components do not agree", func() {
+		m := &money.Money{CurrencyCode: "XYZ", Units: 1, Nanos: -10000000}
+		_, err := Allocate(m, 2, 1, 1)
+		Expect(err).To(MatchError("cannot allocate amount: sign of units component (1) does not agree with sign of nanos component (-10000000)"))
+	})
+
+	It("returns an error if a ratio is negative", func() {
+		m := &money.Money{CurrencyCode: "XYZ", Units: 1}
+		_, err := Allocate(m, 2, 1, -1)
+		Expect(err).To(MatchError("cannot allocate amount: ratio (-1) is negative"))
+	})
+
+	It("returns an error if all ratios are zero", func() {
+		m := &money.Money{CurrencyCode: "XYZ", Units: 1}
+		_, err := Allocate(m, 2, 0, 0)
+		Expect(err).To(MatchError("cannot allocate amount: all ratios are zero"))
+	})
+
+	It("returns an error if the sum of the ratios overflows", func() {
+		m := &money.Money{CurrencyCode: "XYZ", Units: 1}
+		_, err := Allocate(m, 2, math.MaxInt64, math.MaxInt64, math.MaxInt64)
+		Expect(err).To(MatchError("cannot allocate amount: sum of the ratios overflows"))
+	})
+
+	It("panics if no ratios are provided", func() {
+		m := &money.Money{CurrencyCode: "XYZ", Units: 1}
+		Expect(func() {
+			Allocate(m, 2)
+		}).To(PanicWith("at least one ratio must be provided"))
+	})
+
+	It("panics if the number of decimal places is out of range", func() {
+		m := &money.Money{CurrencyCode: "XYZ", Units: 1}
+		Expect(func() {
+			Allocate(m, 10, 1)
+		}).To(PanicWith("decimal places (10) must be between 0 and 9"))
+	})
+})
+
+var _ = Describe("func Split()", func() {
+	It("splits the amount into equal parts", func() {
+		m := &money.Money{CurrencyCode: "XYZ", Units: 10}
+		parts, err := Split(m, 2, 3)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(parts).To(Equal([]*money.Money{
+			{CurrencyCode: "XYZ", Units: 3, Nanos: 340000000},
+			{CurrencyCode: "XYZ", Units: 3, Nanos: 330000000},
+			{CurrencyCode: "XYZ", Units: 3, Nanos: 330000000},
+		}))
+	})
+
+	It("splits a zero amount into zero parts", func() {
+		m := &money.Money{CurrencyCode: "XYZ"}
+		parts, err := Split(m, 2, 2)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(parts).To(Equal([]*money.Money{
+			{CurrencyCode: "XYZ"},
+			{CurrencyCode: "XYZ"},
+		}))
+	})
+
+	It("returns an error if a part would be less than the smallest increment", func() {
+		m := &money.Money{CurrencyCode: "XYZ", Units: 1}
+		_, err := Split(m, 2, 101)
+		Expect(err).To(MatchError("cannot split amount into 101 parts: each part would be less than the smallest increment"))
+	})
+
+	It("returns an error if the amount has more decimal places than requested", func() {
+		m := &money.Money{CurrencyCode: "XYZ", Nanos: 1}
+		_, err := Split(m, 2, 2)
+		Expect(err).To(MatchError("cannot split amount into 2 parts: amount has more than 2 decimal places"))
+	})
+
+	It("panics if the number of parts is not positive", func() {
+		m := &money.Money{CurrencyCode: "XYZ", Units: 1}
+		Expect(func() {
+			Split(m, 2, 0)
+		}).To(PanicWith("number of parts (0) must be positive"))
+	})
+})
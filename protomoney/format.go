@@ -2,13 +2,19 @@ package protomoney
 
 import (
 	"fmt"
-	"math/big"
 
+	"github.com/dogmatiq/dosh/internal/decfmt"
+	"github.com/shopspring/decimal"
 	"google.golang.org/genproto/googleapis/type/money"
 )
 
 // Fmt wraps a money value in a formatter that allows it to be formatted using
 // standard fmt.Printf() verbs.
+//
+// It supports the 'f', 'F', 'e', 'E', 'g', 'G' and 'v' verbs, along with the
+// width, precision and the '+', '-', ' ' and '0' flags, which apply to the
+// magnitude only. The magnitude is formatted exactly, without conversion to a
+// floating-point value.
 func Fmt(m *money.Money) fmt.Formatter {
 	return formatter{m}
 }
@@ -18,23 +24,14 @@ type formatter struct {
 }
 
 func (f formatter) Format(st fmt.State, verb rune) {
-	units, nanos := normalizeComponents(f.value)
-
-	switch verb {
-	case 'f', 'F', // decimal notation
-		'e', 'E', // scientific notation
-		'g', 'G', // decimal notation, or scientific for large exponents
-		'v': // "default" format
-		// This is a subset of the verbs are supported by big.Float.Format().
-
-		s := fmt.Sprintf("%d.%09d", units, nanos)
-		v := &big.Float{}
-		v.SetString(s)
-
-		fmt.Fprintf(st, "%s ", f.value.GetCurrencyCode())
-		v.Format(st, verb)
-
-	default:
+	if !decfmt.Supports(verb) {
 		fmt.Fprintf(st, "%%!%c(*money.Money=%s)", verb, f.value.String())
+		return
 	}
+
+	units, nanos := normalizeComponents(f.value)
+	v := decimal.New(units, 0).Add(decimal.New(int64(nanos), -9))
+
+	fmt.Fprintf(st, "%s ", f.value.GetCurrencyCode())
+	decfmt.Format(st, verb, v)
 }
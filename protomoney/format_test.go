@@ -31,6 +31,26 @@ var _ = Describe("func Fmt()", func() {
 		Expect(s).To(Equal("XYZ 10.13"))
 	})
 
+	It("formats large magnitudes exactly", func() {
+		m := &money.Money{
+			CurrencyCode: "USD",
+			Units:        123456789012345678,
+			Nanos:        123456789,
+		}
+		s := fmt.Sprintf("%.9f", Fmt(m))
+		Expect(s).To(Equal("USD 123456789012345678.123456789"))
+	})
+
+	It("applies the width and flags to the magnitude", func() {
+		m := &money.Money{
+			CurrencyCode: "XYZ",
+			Units:        -10,
+			Nanos:        -129000000,
+		}
+		s := fmt.Sprintf("%-8.2f|", Fmt(m))
+		Expect(s).To(Equal("XYZ -10.13  |"))
+	})
+
 	It("returns a descriptive string if used with an unsupported verb", func() {
 		m := &money.Money{
 			CurrencyCode: "XYZ",
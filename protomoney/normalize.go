@@ -3,7 +3,7 @@ package protomoney
 import (
 	"fmt"
 
-	"github.com/dogmatiq/dosh/internal/currency"
+	"github.com/dogmatiq/dosh/currency"
 	"google.golang.org/genproto/googleapis/type/money"
 )
 
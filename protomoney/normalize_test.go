@@ -1,6 +1,7 @@
 package protomoney_test
 
 import (
+	"github.com/dogmatiq/dosh/currency"
 	. "github.com/dogmatiq/dosh/protomoney"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
@@ -62,3 +63,18 @@ var _ = Describe("func Normalize()", func() {
 		Entry("negative units, positive nanos", &money.Money{CurrencyCode: "XYZ", Units: -1, Nanos: +1}, "sign of units component (-1) does not agree with sign of nanos component (1)"),
 	)
 })
+
+var _ = Describe("func Validate() (with a currency policy)", func() {
+	BeforeEach(func() {
+		currency.SetPolicy(currency.ISOActive)
+	})
+
+	AfterEach(func() {
+		currency.SetPolicy(currency.Lenient)
+	})
+
+	It("returns an error if the currency code is rejected by the current policy", func() {
+		err := Validate(&money.Money{CurrencyCode: "XYZ"})
+		Expect(err).To(MatchError("currency code (XYZ) is invalid, it is not an ISO 4217 currency code"))
+	})
+})
@@ -0,0 +1,220 @@
+package protomoney
+
+import (
+	"fmt"
+
+	"github.com/dogmatiq/dosh/internal/round"
+	"github.com/dogmatiq/dosh/rounding"
+	"github.com/shopspring/decimal"
+	"google.golang.org/genproto/googleapis/type/money"
+)
+
+// RoundToIncrement returns m rounded to an integer multiple of step using the
+// given rounding mode.
+//
+// It returns rounding.ErrInexact if mode is rounding.Unnecessary and m is not already
+// a multiple of step. It returns an error if the signs of the components of m
+// do not agree, if step can not be represented as a whole number of nanos, or
+// if the result overflows the units component. It panics if step is not
+// positive.
+func RoundToIncrement(m *money.Money, step decimal.Decimal, mode rounding.Mode) (*money.Money, error) {
+	if err := rounding.ValidateIncrement(step); err != nil {
+		panic(err)
+	}
+
+	if err := checkSignsAgree(m); err != nil {
+		return nil, err
+	}
+
+	s, err := wholeNanos("rounding increment", step)
+	if err != nil {
+		return nil, fmt.Errorf("cannot round %s amount to an increment: %w", m.CurrencyCode, err)
+	}
+
+	v, neg := nanosOf(m)
+
+	v, err = roundToIncrement(v, s, neg, mode)
+	if err != nil {
+		return nil, err
+	}
+
+	r, ok := fromNanos(m.CurrencyCode, v, neg)
+	if !ok {
+		return nil, fmt.Errorf("cannot round %s amount to an increment: the result overflows the units component", m.CurrencyCode)
+	}
+
+	return r, nil
+}
+
+// RoundToPricePoint returns m rounded to a price described by one of the given
+// price point rules.
+//
+// Each rule is used to round m using the given rounding mode, and the result
+// that is nearest to m is returned. If several rules produce results that are
+// equally near, the earliest rule takes precedence. See
+// dosh.Amount.RoundToPricePoint() for details.
+//
+// It returns rounding.ErrInexact if mode is rounding.Unnecessary and m is not already
+// one of the described prices. It returns an error if no price satisfies the
+// rounding mode, if the signs of the components of m do not agree, if the
+// step or ending of a rule can not be represented as a whole number of nanos,
+// or if the result overflows the units component.
+//
+// It panics if no rules are given, or if any of the rules are invalid.
+func RoundToPricePoint(m *money.Money, mode rounding.Mode, points ...rounding.PricePoint) (*money.Money, error) {
+	if len(points) == 0 {
+		panic("at least one price point must be provided")
+	}
+
+	if err := checkSignsAgree(m); err != nil {
+		return nil, err
+	}
+
+	v, neg := nanosOf(m)
+
+	// md is the mode that is applied to the magnitude v.
+	md := mode
+	if neg {
+		md = round.Mirror(mode)
+	}
+
+	var (
+		best  uint128
+		dist  uint128
+		found bool
+	)
+
+	for _, p := range points {
+		if err := p.Validate(); err != nil {
+			panic(err)
+		}
+
+		step, err := wholeNanos("price point step", p.Step)
+		if err != nil {
+			return nil, fmt.Errorf("cannot round %s amount to a price point: %w", m.CurrencyCode, err)
+		}
+
+		ending, err := wholeNanos("price point ending", p.Ending)
+		if err != nil {
+			return nil, fmt.Errorf("cannot round %s amount to a price point: %w", m.CurrencyCode, err)
+		}
+
+		c, ok, err := roundToPricePoint(v, step, ending, md)
+		if err != nil {
+			if err == rounding.ErrInexact {
+				continue
+			}
+			return nil, err
+		}
+
+		if !ok {
+			continue
+		}
+
+		var d uint128
+		if c.cmp(v) >= 0 {
+			d = c.sub(v)
+		} else {
+			d = v.sub(c)
+		}
+
+		if !found || d.cmp(dist) < 0 {
+			best, dist, found = c, d, true
+		}
+	}
+
+	if !found {
+		if mode == rounding.Unnecessary {
+			return nil, rounding.ErrInexact
+		}
+
+		return nil, fmt.Errorf(
+			"cannot round %s amount to a price point: no price point satisfies the %s rounding mode",
+			m.CurrencyCode,
+			mode,
+		)
+	}
+
+	r, ok := fromNanos(m.CurrencyCode, best, neg)
+	if !ok {
+		return nil, fmt.Errorf("cannot round %s amount to a price point: the result overflows the units component", m.CurrencyCode)
+	}
+
+	return r, nil
+}
+
+// roundToIncrement returns the magnitude v rounded to an integer multiple of
+// step using the given rounding mode. neg is true if the value being rounded is
+// negative.
+func roundToIncrement(v uint128, step uint64, neg bool, mode rounding.Mode) (uint128, error) {
+	q, r := v.quoRem(step)
+	if r == 0 {
+		return v, nil
+	}
+
+	// Compare 2r to step without overflowing.
+	half := 0
+	switch {
+	case r < step-r:
+		half = -1
+	case r > step-r:
+		half = +1
+	}
+
+	away, err := round.Away(mode, neg, half, q.lo%2 != 0)
+	if err != nil {
+		return uint128{}, err
+	}
+
+	if away {
+		q = q.add(uint128{0, 1})
+	}
+
+	return q.mul(step), nil
+}
+
+// roundToPricePoint returns the magnitude v rounded to a price described by
+// the given step and ending using the given rounding mode.
+//
+// ok is false if there is no price that satisfies the rounding mode.
+func roundToPricePoint(v uint128, step, ending uint64, mode rounding.Mode) (_ uint128, ok bool, _ error) {
+	e := uint128{0, ending}
+
+	if v.cmp(e) < 0 {
+		// The magnitude is below the lowest price, which is the ending itself.
+		// Rounding up to the ending is only acceptable if the mode permits
+		// rounding away from zero.
+		switch mode {
+		case rounding.Down, rounding.Floor, rounding.Unnecessary:
+			return uint128{}, false, nil
+		}
+
+		return e, true, nil
+	}
+
+	x, err := roundToIncrement(v.sub(e), step, false, mode)
+	if err != nil {
+		return uint128{}, false, err
+	}
+
+	return x.add(e), true, nil
+}
+
+// wholeNanos returns the non-negative value d as a whole number of nanos.
+//
+// It returns an error if d has more than 9 decimal places, or if it is too
+// large to be represented. name describes d within the error message.
+func wholeNanos(name string, d decimal.Decimal) (uint64, error) {
+	n := d.Shift(9)
+
+	if !n.IsInteger() {
+		return 0, fmt.Errorf("%s (%s) has more than 9 decimal places", name, d)
+	}
+
+	b := n.BigInt()
+	if !b.IsUint64() {
+		return 0, fmt.Errorf("%s (%s) is too large to be expressed in nanos", name, d)
+	}
+
+	return b.Uint64(), nil
+}
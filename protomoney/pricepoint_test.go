@@ -0,0 +1,159 @@
+package protomoney_test
+
+import (
+	"math"
+
+	"github.com/dogmatiq/dosh/currency"
+	. "github.com/dogmatiq/dosh/protomoney"
+	"github.com/dogmatiq/dosh/rounding"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/shopspring/decimal"
+	"google.golang.org/genproto/googleapis/type/money"
+)
+
+var _ = Describe("func RoundToIncrement()", func() {
+	DescribeTable(
+		"it returns the amount rounded to a multiple of the increment",
+		func(m *money.Money, step string, mode rounding.Mode, expect *money.Money) {
+			r, err := RoundToIncrement(m, decimal.RequireFromString(step), mode)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(r).To(Equal(expect))
+		},
+		Entry("half up", &money.Money{CurrencyCode: "XYZ", Units: 1, Nanos: 130000000}, "0.25", rounding.HalfUp, &money.Money{CurrencyCode: "XYZ", Units: 1, Nanos: 250000000}),
+		Entry("half up, tie", &money.Money{CurrencyCode: "XYZ", Units: 1, Nanos: 125000000}, "0.25", rounding.HalfUp, &money.Money{CurrencyCode: "XYZ", Units: 1, Nanos: 250000000}),
+		Entry("half even, tie", &money.Money{CurrencyCode: "XYZ", Units: 1, Nanos: 125000000}, "0.25", rounding.HalfEven, &money.Money{CurrencyCode: "XYZ", Units: 1}),
+		Entry("down", &money.Money{CurrencyCode: "XYZ", Units: 1, Nanos: 240000000}, "0.25", rounding.Down, &money.Money{CurrencyCode: "XYZ", Units: 1}),
+		Entry("negative, ceiling", &money.Money{CurrencyCode: "XYZ", Units: -1, Nanos: -130000000}, "0.25", rounding.Ceiling, &money.Money{CurrencyCode: "XYZ", Units: -1}),
+		Entry("negative, floor", &money.Money{CurrencyCode: "XYZ", Units: -1, Nanos: -130000000}, "0.25", rounding.Floor, &money.Money{CurrencyCode: "XYZ", Units: -1, Nanos: -250000000}),
+		Entry("increment larger than a unit", &money.Money{CurrencyCode: "XYZ", Units: 123}, "50", rounding.HalfUp, &money.Money{CurrencyCode: "XYZ", Units: 100}),
+		Entry("unnormalized amount", &money.Money{CurrencyCode: "XYZ", Nanos: 1130000000}, "0.25", rounding.HalfUp, &money.Money{CurrencyCode: "XYZ", Units: 1, Nanos: 250000000}),
+		Entry("already a multiple", &money.Money{CurrencyCode: "XYZ", Units: 1, Nanos: 250000000}, "0.25", rounding.Unnecessary, &money.Money{CurrencyCode: "XYZ", Units: 1, Nanos: 250000000}),
+		Entry("minimum units", &money.Money{CurrencyCode: "XYZ", Units: math.MinInt64}, "0.25", rounding.Unnecessary, &money.Money{CurrencyCode: "XYZ", Units: math.MinInt64}),
+	)
+
+	It("does not apply the currency policy", func() {
+		currency.SetPolicy(currency.ISOActive)
+		defer currency.SetPolicy(currency.Lenient)
+
+		m := &money.Money{CurrencyCode: "XYZ", Units: 1, Nanos: 130000000}
+		_, err := RoundToIncrement(m, decimal.RequireFromString("0.25"), rounding.HalfUp)
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	It("returns ErrInexact if the mode is Unnecessary and rounding is required", func() {
+		m := &money.Money{CurrencyCode: "XYZ", Units: 1, Nanos: 130000000}
+		_, err := RoundToIncrement(m, decimal.RequireFromString("0.25"), rounding.Unnecessary)
+		Expect(err).To(Equal(rounding.ErrInexact))
+	})
+
+	It("returns an error if the signs of the components do not agree", func() {
+		m := &money.Money{CurrencyCode: "XYZ", Units: 1, Nanos: -130000000}
+		_, err := RoundToIncrement(m, decimal.RequireFromString("0.25"), rounding.HalfUp)
+		Expect(err).To(MatchError("sign of units component (1) does not agree with sign of nanos component (-130000000)"))
+	})
+
+	It("returns an error if the increment has more than 9 decimal places", func() {
+		m := &money.Money{CurrencyCode: "XYZ", Units: 1}
+		_, err := RoundToIncrement(m, decimal.RequireFromString("0.0000000001"), rounding.HalfUp)
+		Expect(err).To(MatchError("cannot round XYZ amount to an increment: rounding increment (0.0000000001) has more than 9 decimal places"))
+	})
+
+	It("returns an error if the increment is too large", func() {
+		m := &money.Money{CurrencyCode: "XYZ", Units: 1}
+		_, err := RoundToIncrement(m, decimal.RequireFromString("100000000000"), rounding.HalfUp)
+		Expect(err).To(MatchError("cannot round XYZ amount to an increment: rounding increment (100000000000) is too large to be expressed in nanos"))
+	})
+
+	It("returns an error if the result overflows the units component", func() {
+		m := &money.Money{CurrencyCode: "XYZ", Units: math.MaxInt64}
+		_, err := RoundToIncrement(m, decimal.RequireFromString("10"), rounding.Up)
+		Expect(err).To(MatchError("cannot round XYZ amount to an increment: the result overflows the units component"))
+	})
+
+	It("panics if the increment is not positive", func() {
+		m := &money.Money{CurrencyCode: "XYZ", Units: 1}
+		Expect(func() {
+			RoundToIncrement(m, decimal.Zero, rounding.HalfUp)
+		}).To(PanicWith(MatchError("rounding increment (0) must be positive")))
+	})
+})
+
+var _ = Describe("func RoundToPricePoint()", func() {
+	ninetyNine := rounding.PricePoint{
+		Step:   decimal.NewFromInt(1),
+		Ending: decimal.RequireFromString("0.99"),
+	}
+
+	ninetyFive := rounding.PricePoint{
+		Step:   decimal.NewFromInt(1),
+		Ending: decimal.RequireFromString("0.95"),
+	}
+
+	DescribeTable(
+		"it returns the amount rounded to a price point",
+		func(m *money.Money, mode rounding.Mode, expect *money.Money, points ...rounding.PricePoint) {
+			r, err := RoundToPricePoint(m, mode, points...)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(r).To(Equal(expect))
+		},
+		Entry(".99, ceiling", &money.Money{CurrencyCode: "XYZ", Units: 12, Nanos: 300000000}, rounding.Ceiling, &money.Money{CurrencyCode: "XYZ", Units: 12, Nanos: 990000000}, ninetyNine),
+		Entry(".99, nearest below", &money.Money{CurrencyCode: "XYZ", Units: 12, Nanos: 300000000}, rounding.HalfUp, &money.Money{CurrencyCode: "XYZ", Units: 11, Nanos: 990000000}, ninetyNine),
+		Entry(".99, down", &money.Money{CurrencyCode: "XYZ", Units: 12, Nanos: 980000000}, rounding.Down, &money.Money{CurrencyCode: "XYZ", Units: 11, Nanos: 990000000}, ninetyNine),
+		Entry(".99, below the lowest price point", &money.Money{CurrencyCode: "XYZ", Nanos: 100000000}, rounding.HalfUp, &money.Money{CurrencyCode: "XYZ", Nanos: 990000000}, ninetyNine),
+		Entry("nearest of several rules", &money.Money{CurrencyCode: "XYZ", Units: 12, Nanos: 960000000}, rounding.HalfUp, &money.Money{CurrencyCode: "XYZ", Units: 12, Nanos: 950000000}, ninetyNine, ninetyFive),
+		Entry("equally near, earlier rule takes precedence", &money.Money{CurrencyCode: "XYZ", Units: 12, Nanos: 970000000}, rounding.HalfUp, &money.Money{CurrencyCode: "XYZ", Units: 12, Nanos: 990000000}, ninetyNine, ninetyFive),
+		Entry("negative, ceiling", &money.Money{CurrencyCode: "XYZ", Units: -12, Nanos: -300000000}, rounding.Ceiling, &money.Money{CurrencyCode: "XYZ", Units: -11, Nanos: -990000000}, ninetyNine),
+		Entry("negative, floor", &money.Money{CurrencyCode: "XYZ", Units: -12, Nanos: -300000000}, rounding.Floor, &money.Money{CurrencyCode: "XYZ", Units: -12, Nanos: -990000000}, ninetyNine),
+	)
+
+	It("returns ErrInexact if the mode is Unnecessary and the amount is not a price point", func() {
+		m := &money.Money{CurrencyCode: "XYZ", Units: 12, Nanos: 500000000}
+		_, err := RoundToPricePoint(m, rounding.Unnecessary, ninetyNine, ninetyFive)
+		Expect(err).To(Equal(rounding.ErrInexact))
+	})
+
+	DescribeTable(
+		"it returns an error if no price point satisfies the rounding mode",
+		func(m *money.Money, mode rounding.Mode, expect string) {
+			_, err := RoundToPricePoint(m, mode, ninetyNine)
+			Expect(err).To(MatchError(expect))
+		},
+		Entry("floor", &money.Money{CurrencyCode: "XYZ", Nanos: 500000000}, rounding.Floor, "cannot round XYZ amount to a price point: no price point satisfies the Floor rounding mode"),
+		Entry("down", &money.Money{CurrencyCode: "XYZ", Nanos: 500000000}, rounding.Down, "cannot round XYZ amount to a price point: no price point satisfies the Down rounding mode"),
+		Entry("negative, ceiling", &money.Money{CurrencyCode: "XYZ", Nanos: -500000000}, rounding.Ceiling, "cannot round XYZ amount to a price point: no price point satisfies the Ceiling rounding mode"),
+	)
+
+	It("returns an error if the signs of the components do not agree", func() {
+		m := &money.Money{CurrencyCode: "XYZ", Units: 1, Nanos: -130000000}
+		_, err := RoundToPricePoint(m, rounding.HalfUp, ninetyNine)
+		Expect(err).To(MatchError("sign of units component (1) does not agree with sign of nanos component (-130000000)"))
+	})
+
+	It("returns an error if the ending has more than 9 decimal places", func() {
+		m := &money.Money{CurrencyCode: "XYZ", Units: 1}
+		_, err := RoundToPricePoint(m, rounding.HalfUp, rounding.PricePoint{
+			Step:   decimal.NewFromInt(1),
+			Ending: decimal.RequireFromString("0.9999999999"),
+		})
+		Expect(err).To(MatchError("cannot round XYZ amount to a price point: price point ending (0.9999999999) has more than 9 decimal places"))
+	})
+
+	It("panics if no price points are provided", func() {
+		m := &money.Money{CurrencyCode: "XYZ", Units: 1}
+		Expect(func() {
+			RoundToPricePoint(m, rounding.HalfUp)
+		}).To(PanicWith("at least one price point must be provided"))
+	})
+
+	It("panics if the ending is not less than the step", func() {
+		m := &money.Money{CurrencyCode: "XYZ", Units: 1}
+		Expect(func() {
+			RoundToPricePoint(m, rounding.HalfUp, rounding.PricePoint{
+				Step:   decimal.NewFromInt(1),
+				Ending: decimal.NewFromInt(1),
+			})
+		}).To(PanicWith(MatchError("price point ending (1) must be non-negative and less than the step (1)")))
+	})
+})
@@ -0,0 +1,154 @@
+package protomoney
+
+import (
+	"fmt"
+
+	"github.com/dogmatiq/dosh/internal/round"
+	"github.com/dogmatiq/dosh/rounding"
+	"google.golang.org/genproto/googleapis/type/money"
+)
+
+// RoundWith returns m rounded to n decimal places using the given rounding
+// mode.
+//
+// If n is negative the result is rounded to the -n'th integer place. n must not
+// be less than -18. If n is 9 or more m is returned unchanged, as the nanos
+// component can not represent more than 9 decimal places.
+//
+// It returns rounding.ErrInexact if mode is rounding.Unnecessary and m can not be
+// represented exactly with n decimal places. It returns an error if the signs
+// of the components of m do not agree, or if the result overflows the units
+// component.
+func RoundWith(m *money.Money, n int32, mode rounding.Mode) (*money.Money, error) {
+	if err := checkSignsAgree(m); err != nil {
+		return nil, err
+	}
+
+	if n >= 9 {
+		return m, nil
+	}
+
+	if n < -18 {
+		return nil, fmt.Errorf("cannot round to %d decimal places, the minimum is -18", n)
+	}
+
+	units, nanos := normalizeComponents(m)
+
+	var (
+		neg  = units < 0 || nanos < 0
+		half int
+		odd  bool
+		step int64
+	)
+
+	if n >= 0 {
+		// The digits to discard are entirely within the nanos component.
+		step = pow10(9 - n)
+		r := nanos % int32(step)
+		if r == 0 {
+			return m, nil
+		}
+
+		nanos -= r
+		half = compareHalf(abs(int64(r)), step, false)
+
+		if n == 0 {
+			odd = units%2 != 0
+		} else {
+			odd = (nanos/int32(step))%2 != 0
+		}
+	} else {
+		// The digits to discard include the entire nanos component, and some
+		// digits of the units component.
+		step = pow10(-n)
+		r := units % step
+		if r == 0 && nanos == 0 {
+			return m, nil
+		}
+
+		units -= r
+		half = compareHalf(abs(r), step, nanos != 0)
+		odd = (units/step)%2 != 0
+		nanos = 0
+		step = 0 // signal that the increment applies to units
+	}
+
+	away, err := round.Away(mode, neg, half, odd)
+	if err != nil {
+		return nil, err
+	}
+
+	if away {
+		sign := int64(1)
+		if neg {
+			sign = -1
+		}
+
+		if step == 0 {
+			inc := sign * pow10(-n)
+			if (inc > 0 && units > maxInt64-inc) || (inc < 0 && units < minInt64-inc) {
+				return nil, fmt.Errorf("cannot round to %d decimal places, the result overflows the units component", n)
+			}
+			units += inc
+		} else {
+			nanos += int32(sign * step)
+		}
+	}
+
+	r := &money.Money{
+		CurrencyCode: m.CurrencyCode,
+		Units:        units,
+		Nanos:        nanos,
+	}
+
+	if !isNormalized(r) {
+		if (r.Units == maxInt64 && r.Nanos > 0) || (r.Units == minInt64 && r.Nanos < 0) {
+			return nil, fmt.Errorf("cannot round to %d decimal places, the result overflows the units component", n)
+		}
+
+		normalizeInPlace(r)
+	}
+
+	return r, nil
+}
+
+const (
+	maxInt64 = 1<<63 - 1
+	minInt64 = -1 << 63
+)
+
+// compareHalf compares the discarded portion of a value to one half of step.
+//
+// r is the magnitude of the discarded digits, expressed in the same units as
+// step. If more is true there are further non-zero digits beyond r, such that
+// the discarded portion is slightly more than r.
+func compareHalf(r, step int64, more bool) int {
+	// r < step, so 2*r can not overflow.
+	switch c := 2 * r; {
+	case c < step:
+		return -1
+	case c > step:
+		return +1
+	case more:
+		return +1
+	default:
+		return 0
+	}
+}
+
+// pow10 returns 10^n.
+func pow10(n int32) int64 {
+	v := int64(1)
+	for ; n > 0; n-- {
+		v *= 10
+	}
+	return v
+}
+
+// abs returns the absolute value of v.
+func abs(v int64) int64 {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
@@ -0,0 +1,154 @@
+package protomoney_test
+
+import (
+	. "github.com/dogmatiq/dosh/protomoney"
+	"github.com/dogmatiq/dosh/rounding"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"google.golang.org/genproto/googleapis/type/money"
+)
+
+var _ = Describe("func RoundWith()", func() {
+	DescribeTable(
+		"it returns an amount with the magnitude rounded using the given mode",
+		func(n int32, mode rounding.Mode, m, expect *money.Money) {
+			r, err := RoundWith(m, n, mode)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(r).To(Equal(expect))
+		},
+		Entry(
+			"HalfUp, half",
+			int32(1), rounding.HalfUp,
+			&money.Money{CurrencyCode: "XYZ", Units: 1, Nanos: 250000000},
+			&money.Money{CurrencyCode: "XYZ", Units: 1, Nanos: 300000000},
+		),
+		Entry(
+			"HalfDown, half",
+			int32(1), rounding.HalfDown,
+			&money.Money{CurrencyCode: "XYZ", Units: 1, Nanos: 250000000},
+			&money.Money{CurrencyCode: "XYZ", Units: 1, Nanos: 200000000},
+		),
+		Entry(
+			"HalfEven, half with even neighbor below",
+			int32(1), rounding.HalfEven,
+			&money.Money{CurrencyCode: "XYZ", Units: 1, Nanos: 250000000},
+			&money.Money{CurrencyCode: "XYZ", Units: 1, Nanos: 200000000},
+		),
+		Entry(
+			"HalfEven, half with even neighbor above",
+			int32(1), rounding.HalfEven,
+			&money.Money{CurrencyCode: "XYZ", Units: 1, Nanos: 350000000},
+			&money.Money{CurrencyCode: "XYZ", Units: 1, Nanos: 400000000},
+		),
+		Entry(
+			"HalfEven, zero places uses the parity of the units",
+			int32(0), rounding.HalfEven,
+			&money.Money{CurrencyCode: "XYZ", Units: 3, Nanos: 500000000},
+			&money.Money{CurrencyCode: "XYZ", Units: 4},
+		),
+		Entry(
+			"HalfOdd, half",
+			int32(0), rounding.HalfOdd,
+			&money.Money{CurrencyCode: "XYZ", Units: 3, Nanos: 500000000},
+			&money.Money{CurrencyCode: "XYZ", Units: 3},
+		),
+		Entry(
+			"Up, carries into units",
+			int32(2), rounding.Up,
+			&money.Money{CurrencyCode: "XYZ", Units: 1, Nanos: 995000001},
+			&money.Money{CurrencyCode: "XYZ", Units: 2},
+		),
+		Entry(
+			"Up, negative",
+			int32(0), rounding.Up,
+			&money.Money{CurrencyCode: "XYZ", Units: -1, Nanos: -100000000},
+			&money.Money{CurrencyCode: "XYZ", Units: -2},
+		),
+		Entry(
+			"Down, negative",
+			int32(0), rounding.Down,
+			&money.Money{CurrencyCode: "XYZ", Units: -1, Nanos: -900000000},
+			&money.Money{CurrencyCode: "XYZ", Units: -1},
+		),
+		Entry(
+			"Ceiling, negative",
+			int32(0), rounding.Ceiling,
+			&money.Money{CurrencyCode: "XYZ", Units: -1, Nanos: -900000000},
+			&money.Money{CurrencyCode: "XYZ", Units: -1},
+		),
+		Entry(
+			"Floor, negative",
+			int32(0), rounding.Floor,
+			&money.Money{CurrencyCode: "XYZ", Units: -1, Nanos: -100000000},
+			&money.Money{CurrencyCode: "XYZ", Units: -2},
+		),
+		Entry(
+			"negative places, below half",
+			int32(-1), rounding.HalfUp,
+			&money.Money{CurrencyCode: "XYZ", Units: 544, Nanos: 999999999},
+			&money.Money{CurrencyCode: "XYZ", Units: 540},
+		),
+		Entry(
+			"negative places, half",
+			int32(-1), rounding.HalfEven,
+			&money.Money{CurrencyCode: "XYZ", Units: 545},
+			&money.Money{CurrencyCode: "XYZ", Units: 540},
+		),
+		Entry(
+			"negative places, nanos break the tie",
+			int32(-1), rounding.HalfEven,
+			&money.Money{CurrencyCode: "XYZ", Units: 545, Nanos: 1},
+			&money.Money{CurrencyCode: "XYZ", Units: 550},
+		),
+		Entry(
+			"negative places, negative",
+			int32(-2), rounding.Floor,
+			&money.Money{CurrencyCode: "XYZ", Units: -101},
+			&money.Money{CurrencyCode: "XYZ", Units: -200},
+		),
+	)
+
+	It("returns the amount unchanged if no rounding is required", func() {
+		m := &money.Money{CurrencyCode: "XYZ", Units: 1, Nanos: 200000000}
+		r, err := RoundWith(m, 1, rounding.Unnecessary)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(r).To(BeIdenticalTo(m))
+	})
+
+	It("returns the amount unchanged if there are 9 or more decimal places", func() {
+		m := &money.Money{CurrencyCode: "XYZ", Units: 1, Nanos: 123456789}
+		r, err := RoundWith(m, 9, rounding.HalfUp)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(r).To(BeIdenticalTo(m))
+	})
+
+	It("returns ErrInexact if the mode is Unnecessary and rounding is required", func() {
+		m := &money.Money{CurrencyCode: "XYZ", Units: 1, Nanos: 250000000}
+		_, err := RoundWith(m, 1, rounding.Unnecessary)
+		Expect(err).To(Equal(rounding.ErrInexact))
+	})
+
+	It("returns an error if the result overflows", func() {
+		m := &money.Money{CurrencyCode: "XYZ", Units: 9223372036854775807, Nanos: 500000000}
+		_, err := RoundWith(m, 0, rounding.HalfUp)
+		Expect(err).To(MatchError("cannot round to 0 decimal places, the result overflows the units component"))
+	})
+
+	DescribeTable(
+		"it returns an error if the signs of the components do not agree",
+		func(n int32) {
+			m := &money.Money{CurrencyCode: "XYZ", Units: 1, Nanos: -500000000}
+			_, err := RoundWith(m, n, rounding.HalfUp)
+			Expect(err).To(MatchError("sign of units component (1) does not agree with sign of nanos component (-500000000)"))
+		},
+		Entry("rounding required", int32(0)),
+		Entry("9 or more decimal places", int32(9)),
+	)
+
+	It("returns an error if the number of places is too small", func() {
+		m := &money.Money{CurrencyCode: "XYZ", Units: 1}
+		_, err := RoundWith(m, -19, rounding.HalfUp)
+		Expect(err).To(MatchError("cannot round to -19 decimal places, the minimum is -18"))
+	})
+})
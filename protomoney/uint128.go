@@ -0,0 +1,107 @@
+package protomoney
+
+import (
+	"math/bits"
+
+	"google.golang.org/genproto/googleapis/type/money"
+)
+
+// uint128 is an unsigned 128-bit integer.
+//
+// It is large enough to hold the magnitude of any *money.Money value expressed
+// as a number of nanos, along with any intermediate results of rounding it.
+type uint128 struct {
+	hi, lo uint64
+}
+
+// nanosOf returns the magnitude of m as a number of nanos.
+//
+// neg is true if m is negative. The signs of the units and nanos components of
+// m must agree.
+func nanosOf(m *money.Money) (_ uint128, neg bool) {
+	units, nanos := normalizeComponents(m)
+	neg = units < 0 || nanos < 0
+
+	// The conversion to uint64 produces the correct magnitude even for the
+	// minimum int64.
+	u, n := uint64(units), uint64(int64(nanos))
+	if neg {
+		u, n = -u, -n
+	}
+
+	hi, lo := bits.Mul64(u, nanosPerUnit)
+	return uint128{hi, lo}.add(uint128{0, n}), neg
+}
+
+// fromNanos returns a *money.Money with a magnitude of v nanos.
+//
+// ok is false if the magnitude can not be represented by the units component.
+func fromNanos(c string, v uint128, neg bool) (_ *money.Money, ok bool) {
+	q, r := v.quoRem(nanosPerUnit)
+
+	if q.hi != 0 || q.lo > maxInt64+1 {
+		return nil, false
+	}
+
+	// A magnitude of exactly 2^63 units can only be represented if the value is
+	// negative and there are no nanos.
+	if q.lo == maxInt64+1 && (!neg || r != 0) {
+		return nil, false
+	}
+
+	m := &money.Money{
+		CurrencyCode: c,
+		Units:        int64(q.lo),
+		Nanos:        int32(r),
+	}
+
+	if neg {
+		m.Units = -m.Units
+		m.Nanos = -m.Nanos
+	}
+
+	return m, true
+}
+
+// add returns a + b. The result wraps on overflow.
+func (a uint128) add(b uint128) uint128 {
+	lo, carry := bits.Add64(a.lo, b.lo, 0)
+	hi, _ := bits.Add64(a.hi, b.hi, carry)
+	return uint128{hi, lo}
+}
+
+// sub returns a - b. The result wraps on underflow.
+func (a uint128) sub(b uint128) uint128 {
+	lo, borrow := bits.Sub64(a.lo, b.lo, 0)
+	hi, _ := bits.Sub64(a.hi, b.hi, borrow)
+	return uint128{hi, lo}
+}
+
+// mul returns a × b. The result wraps on overflow.
+func (a uint128) mul(b uint64) uint128 {
+	hi, lo := bits.Mul64(a.lo, b)
+	return uint128{hi + a.hi*b, lo}
+}
+
+// quoRem returns the quotient and remainder of a / b. It panics if b is zero.
+func (a uint128) quoRem(b uint64) (uint128, uint64) {
+	hi, r := bits.Div64(0, a.hi, b)
+	lo, r := bits.Div64(r, a.lo, b)
+	return uint128{hi, lo}, r
+}
+
+// cmp compares a and b, returning -1 if a < b, 0 if a == b and +1 if a > b.
+func (a uint128) cmp(b uint128) int {
+	switch {
+	case a.hi < b.hi:
+		return -1
+	case a.hi > b.hi:
+		return +1
+	case a.lo < b.lo:
+		return -1
+	case a.lo > b.lo:
+		return +1
+	default:
+		return 0
+	}
+}
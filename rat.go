@@ -0,0 +1,310 @@
+package dosh
+
+import (
+	"math/big"
+
+	"github.com/dogmatiq/dosh/internal/round"
+	"github.com/shopspring/decimal"
+)
+
+// RatAmount is an immutable amount of money with an exact rational magnitude.
+//
+// It is intended for multi-step calculations that would otherwise lose
+// precision at each division, such as "divide by 3, multiply by 7, convert at
+// 1.0834". The calculation is performed exactly using a RatAmount, and the
+// result is rounded exactly once when it is converted back to an Amount using
+// Round() or RoundToMinorUnit().
+//
+// A RatAmount is obtained by calling Amount.Rat(). Like Amount, the zero-value
+// represents zero in the default currency.
+type RatAmount struct {
+	_ [0]func() // prevent comparison with ==
+
+	// cur is the currency code that idenfifies what currency the magnitude is
+	// expressed in.
+	//
+	// An empty string is equivalent to the default currency.
+	cur string
+
+	// mag is the monetary amount, expressed in whatever currency is specified
+	// by the currency field. A nil pointer is equivalent to zero.
+	//
+	// The value pointed to must never be modified, as it may be shared by
+	// multiple RatAmount values.
+	mag *big.Rat
+}
+
+// Rat returns the amount as a RatAmount, with an exact rational magnitude.
+func (a Amount) Rat() RatAmount {
+	return RatAmount{
+		cur: a.cur,
+		mag: a.mag.Rat(),
+	}
+}
+
+// CurrencyCode returns the currency code of the currency in which the amount
+// is specified.
+func (a RatAmount) CurrencyCode() string {
+	return a.amount().CurrencyCode()
+}
+
+// Magnitude returns the rational value of the amount without currency
+// information.
+//
+// The returned value is a copy, and may be freely modified by the caller.
+func (a RatAmount) Magnitude() *big.Rat {
+	return new(big.Rat).Set(a.rat())
+}
+
+// String returns a human-readable representation of the amount, including the
+// currency code.
+//
+// The magnitude is represented as a fraction, such as "USD 10/3", unless it is
+// an integer.
+func (a RatAmount) String() string {
+	c := a.CurrencyCode()
+	if c == "" {
+		c = "<no currency>"
+	}
+
+	return c + " " + a.rat().RatString()
+}
+
+// IsZero returns true if the amount has a magnitude of zero.
+func (a RatAmount) IsZero() bool {
+	return a.rat().Sign() == 0
+}
+
+// IsPositive returns true if the amount has a positive magnitude.
+func (a RatAmount) IsPositive() bool {
+	return a.rat().Sign() > 0
+}
+
+// IsNegative returns true if the amount has a negative magnitude.
+func (a RatAmount) IsNegative() bool {
+	return a.rat().Sign() < 0
+}
+
+// Cmp compares a to b and returns a C-style comparison result.
+//
+// It panics if a and b do not use the same currency.
+//
+// If a < b then c is negative.
+// If a > b then c is positive.
+// Otherwise; a == b and c is zero.
+func (a RatAmount) Cmp(b RatAmount) (c int) {
+	assertSameCurrency(a.amount(), b.amount())
+	return a.rat().Cmp(b.rat())
+}
+
+// EqualTo returns true if a and b have the same magnitude.
+//
+// It panics if a and b do not use the same currency.
+func (a RatAmount) EqualTo(b RatAmount) bool {
+	return a.Cmp(b) == 0
+}
+
+// LessThan returns true if a < b.
+//
+// It panics if a and b do not use the same currency.
+func (a RatAmount) LessThan(b RatAmount) bool {
+	return a.Cmp(b) < 0
+}
+
+// LessThanOrEqualTo returns true if a <= b.
+//
+// It panics if a and b do not use the same currency.
+func (a RatAmount) LessThanOrEqualTo(b RatAmount) bool {
+	return a.Cmp(b) <= 0
+}
+
+// GreaterThan returns true if a > b.
+//
+// It panics if a and b do not use the same currency.
+func (a RatAmount) GreaterThan(b RatAmount) bool {
+	return a.Cmp(b) > 0
+}
+
+// GreaterThanOrEqualTo returns true if a >= b.
+//
+// It panics if a and b do not use the same currency.
+func (a RatAmount) GreaterThanOrEqualTo(b RatAmount) bool {
+	return a.Cmp(b) >= 0
+}
+
+// Abs returns an amount with the absolute value of a's magnitude.
+func (a RatAmount) Abs() RatAmount {
+	assertHasCurrency(a.amount())
+	a.mag = new(big.Rat).Abs(a.rat())
+	return a
+}
+
+// Neg returns an amount with the negated value of a's magnitude.
+func (a RatAmount) Neg() RatAmount {
+	assertHasCurrency(a.amount())
+	a.mag = new(big.Rat).Neg(a.rat())
+	return a
+}
+
+// Add returns a + b.
+//
+// It panics if a and b do not use the same currency.
+func (a RatAmount) Add(b RatAmount) RatAmount {
+	assertSameCurrency(a.amount(), b.amount())
+	a.mag = new(big.Rat).Add(a.rat(), b.rat())
+	return a
+}
+
+// Sub returns a - b.
+//
+// It panics if a and b do not use the same currency.
+func (a RatAmount) Sub(b RatAmount) RatAmount {
+	assertSameCurrency(a.amount(), b.amount())
+	a.mag = new(big.Rat).Sub(a.rat(), b.rat())
+	return a
+}
+
+// MulScalar returns a * b, where b is a scalar decimal value.
+func (a RatAmount) MulScalar(b decimal.Decimal) RatAmount {
+	assertHasCurrency(a.amount())
+	a.mag = new(big.Rat).Mul(a.rat(), b.Rat())
+	return a
+}
+
+// DivScalar returns a / b, where b is a scalar decimal value.
+//
+// The result is exact. It panics if b is zero.
+func (a RatAmount) DivScalar(b decimal.Decimal) RatAmount {
+	assertHasCurrency(a.amount())
+
+	if b.IsZero() {
+		panic("decimal division by 0")
+	}
+
+	a.mag = new(big.Rat).Quo(a.rat(), b.Rat())
+	return a
+}
+
+// Div returns a / b.
+//
+// It panics if a and b do not use the same currency, or if b is zero.
+func (a RatAmount) Div(b RatAmount) *big.Rat {
+	assertSameCurrency(a.amount(), b.amount())
+
+	if b.IsZero() {
+		panic("decimal division by 0")
+	}
+
+	return new(big.Rat).Quo(a.rat(), b.rat())
+}
+
+// Round returns the amount as an Amount, rounded to n decimal places using the
+// given rounding mode.
+//
+// If n is negative the result is rounded to the -n'th integer place. It
+// returns ErrInexact if mode is Unnecessary and the amount can not be
+// represented exactly with n decimal places.
+func (a RatAmount) Round(n int32, mode RoundingMode) (Amount, error) {
+	assertHasCurrency(a.amount())
+
+	m, err := roundRat(a.rat(), n, mode)
+	if err != nil {
+		return Amount{}, err
+	}
+
+	return Amount{cur: a.cur, mag: m}, nil
+}
+
+// RoundToMinorUnit returns the amount as an Amount, rounded to the precision
+// of its currency's minor unit using the given rounding mode.
+//
+// It returns an error under the same conditions as Amount.RoundToMinorUnit().
+func (a RatAmount) RoundToMinorUnit(mode RoundingMode) (Amount, error) {
+	n, err := minorUnits(a.amount())
+	if err != nil {
+		return Amount{}, err
+	}
+
+	return a.Round(n, mode)
+}
+
+// Exact returns the amount as an Amount, without rounding.
+//
+// ok is false if the magnitude can not be represented exactly as a decimal,
+// that is, if it has an infinite number of decimal places.
+func (a RatAmount) Exact() (_ Amount, ok bool) {
+	assertHasCurrency(a.amount())
+
+	r := a.rat()
+	m, ok := divExact(
+		decimal.NewFromBigInt(r.Num(), 0),
+		decimal.NewFromBigInt(r.Denom(), 0),
+	)
+	if !ok {
+		return Amount{}, false
+	}
+
+	return Amount{cur: a.cur, mag: m}, true
+}
+
+// rat returns the magnitude, treating a nil pointer as zero.
+func (a RatAmount) rat() *big.Rat {
+	if a.mag == nil {
+		return new(big.Rat)
+	}
+
+	return a.mag
+}
+
+// amount returns a zero Amount with the same currency as a, for use with
+// the currency assertions shared with Amount.
+func (a RatAmount) amount() Amount {
+	return Amount{cur: a.cur}
+}
+
+// roundRat returns r rounded to n decimal places using the given rounding
+// mode.
+func roundRat(r *big.Rat, n int32, mode RoundingMode) (decimal.Decimal, error) {
+	num := new(big.Int).Abs(r.Num())
+	den := new(big.Int).Set(r.Denom())
+
+	// Scale the value such that the digits to be discarded are entirely within
+	// the fractional part of num / den.
+	scale := new(big.Int).Exp(bigTen, big.NewInt(int64(abs32(n))), nil)
+	if n >= 0 {
+		num.Mul(num, scale)
+	} else {
+		den.Mul(den, scale)
+	}
+
+	q, rem := new(big.Int).QuoRem(num, den, new(big.Int))
+
+	if rem.Sign() != 0 {
+		neg := r.Sign() < 0
+		odd := q.Bit(0) != 0
+		half := new(big.Int).Lsh(rem, 1).Cmp(den)
+
+		away, err := round.Away(mode, neg, half, odd)
+		if err != nil {
+			return decimal.Decimal{}, err
+		}
+
+		if away {
+			q.Add(q, bigOne)
+		}
+	}
+
+	if r.Sign() < 0 {
+		q.Neg(q)
+	}
+
+	return decimal.NewFromBigInt(q, -n), nil
+}
+
+// abs32 returns the absolute value of v.
+func abs32(v int32) int32 {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
@@ -0,0 +1,201 @@
+package dosh_test
+
+import (
+	"math/big"
+
+	. "github.com/dogmatiq/dosh"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/shopspring/decimal"
+)
+
+var _ = Describe("type RatAmount", func() {
+	third := func() RatAmount {
+		return Unit("USD").Rat().DivScalar(decimal.NewFromInt(3))
+	}
+
+	Describe("func Rat()", func() {
+		It("returns an amount with the same currency and magnitude", func() {
+			r := FromString("USD", "1.25").Rat()
+			Expect(r.CurrencyCode()).To(Equal("USD"))
+			Expect(r.Magnitude()).To(Equal(big.NewRat(5, 4)))
+		})
+	})
+
+	Describe("func String()", func() {
+		It("returns the magnitude as a fraction", func() {
+			Expect(third().String()).To(Equal("USD 1/3"))
+		})
+
+		It("returns the magnitude as an integer if it is a whole number", func() {
+			Expect(FromInt("USD", 3).Rat().String()).To(Equal("USD 3"))
+		})
+	})
+
+	Describe("func Magnitude()", func() {
+		It("returns a copy of the magnitude", func() {
+			r := third()
+			r.Magnitude().SetInt64(100)
+			Expect(r.Magnitude()).To(Equal(big.NewRat(1, 3)))
+		})
+	})
+
+	It("performs multi-step calculations exactly", func() {
+		r := FromInt("USD", 100).Rat().
+			DivScalar(decimal.NewFromInt(3)).
+			MulScalar(decimal.NewFromInt(7)).
+			MulScalar(decimal.RequireFromString("1.0834"))
+
+		// 100 / 3 * 7 * 1.0834 = 252.79333...
+		a, err := r.RoundToMinorUnit(HalfUp)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(a.IdenticalTo(FromString("USD", "252.79"))).To(BeTrue())
+
+		// Dividing by 3 and multiplying by 3 results in exactly the original
+		// value.
+		b, ok := third().MulScalar(decimal.NewFromInt(3)).Exact()
+		Expect(ok).To(BeTrue())
+		Expect(b.IdenticalTo(Unit("USD"))).To(BeTrue())
+	})
+
+	Describe("func Add()", func() {
+		It("returns a + b", func() {
+			r := third().Add(third())
+			Expect(r.Magnitude()).To(Equal(big.NewRat(2, 3)))
+		})
+
+		It("panics if the amounts do not have the same currency", func() {
+			Expect(func() {
+				third().Add(Unit("EUR").Rat())
+			}).To(PanicWith("can not operate on amounts in differing currencies (USD vs EUR)"))
+		})
+	})
+
+	Describe("func Sub()", func() {
+		It("returns a - b", func() {
+			r := Unit("USD").Rat().Sub(third())
+			Expect(r.Magnitude()).To(Equal(big.NewRat(2, 3)))
+		})
+	})
+
+	Describe("func Abs()", func() {
+		It("returns the absolute value", func() {
+			Expect(third().Neg().Abs().EqualTo(third())).To(BeTrue())
+		})
+	})
+
+	Describe("func Neg()", func() {
+		It("returns the negated value", func() {
+			Expect(third().Neg().Magnitude()).To(Equal(big.NewRat(-1, 3)))
+		})
+	})
+
+	Describe("func DivScalar()", func() {
+		It("panics when dividing by zero", func() {
+			Expect(func() {
+				third().DivScalar(decimal.Zero)
+			}).To(PanicWith("decimal division by 0"))
+		})
+	})
+
+	Describe("func Div()", func() {
+		It("returns a / b", func() {
+			Expect(Unit("USD").Rat().Div(third())).To(Equal(big.NewRat(3, 1)))
+		})
+
+		It("panics when dividing by zero", func() {
+			Expect(func() {
+				third().Div(Zero("USD").Rat())
+			}).To(PanicWith("decimal division by 0"))
+		})
+	})
+
+	Describe("comparison methods", func() {
+		It("compares the magnitudes", func() {
+			a := third()
+			b := third().Add(third())
+
+			Expect(a.Cmp(b)).To(Equal(-1))
+			Expect(a.EqualTo(third())).To(BeTrue())
+			Expect(a.LessThan(b)).To(BeTrue())
+			Expect(a.LessThanOrEqualTo(a)).To(BeTrue())
+			Expect(b.GreaterThan(a)).To(BeTrue())
+			Expect(b.GreaterThanOrEqualTo(b)).To(BeTrue())
+			Expect(a.IsPositive()).To(BeTrue())
+			Expect(a.Neg().IsNegative()).To(BeTrue())
+			Expect(a.Sub(a).IsZero()).To(BeTrue())
+		})
+
+		It("panics if the amounts do not have the same currency", func() {
+			Expect(func() {
+				third().Cmp(Unit("EUR").Rat())
+			}).To(PanicWith("can not operate on amounts in differing currencies (USD vs EUR)"))
+		})
+	})
+
+	Describe("func Round()", func() {
+		DescribeTable(
+			"it returns the amount rounded using the given mode",
+			func(num, den int64, n int32, mode RoundingMode, expect string) {
+				r := FromInt("USD", int(num)).Rat().DivScalar(decimal.NewFromInt(den))
+				a, err := r.Round(n, mode)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(a.IdenticalTo(FromString("USD", expect))).To(
+					BeTrue(),
+					"rounded to %s, expected %s",
+					a.Magnitude(),
+					expect,
+				)
+			},
+			Entry("non-terminating, HalfUp", int64(2), int64(3), int32(2), HalfUp, "0.67"),
+			Entry("non-terminating, Down", int64(2), int64(3), int32(2), Down, "0.66"),
+			Entry("negative, Floor", int64(-1), int64(3), int32(2), Floor, "-0.34"),
+			Entry("negative, Ceiling", int64(-1), int64(3), int32(2), Ceiling, "-0.33"),
+			Entry("half, HalfEven", int64(1), int64(8), int32(2), HalfEven, "0.12"),
+			Entry("half, HalfUp", int64(1), int64(8), int32(2), HalfUp, "0.13"),
+			Entry("negative places", int64(1000), int64(3), int32(-1), HalfUp, "330"),
+			Entry("exact", int64(1), int64(4), int32(2), Unnecessary, "0.25"),
+		)
+
+		It("returns ErrInexact if the mode is Unnecessary and rounding is required", func() {
+			_, err := third().Round(2, Unnecessary)
+			Expect(err).To(Equal(ErrInexact))
+		})
+	})
+
+	Describe("func RoundToMinorUnit()", func() {
+		It("rounds to the precision of the currency's minor unit", func() {
+			a, err := FromInt("JPY", 1000).Rat().DivScalar(decimal.NewFromInt(3)).RoundToMinorUnit(HalfUp)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(a.IdenticalTo(FromInt("JPY", 333))).To(BeTrue())
+		})
+
+		It("returns an error if the currency has no minor unit", func() {
+			_, err := Unit("XAU").Rat().RoundToMinorUnit(HalfUp)
+			Expect(err).To(MatchError("cannot round XAU amount to its minor unit: currency has no minor unit"))
+		})
+	})
+
+	Describe("func Exact()", func() {
+		It("returns the amount if it has a finite decimal representation", func() {
+			a, ok := Unit("USD").Rat().DivScalar(decimal.NewFromInt(8)).Exact()
+			Expect(ok).To(BeTrue())
+			Expect(a.EqualTo(FromString("USD", "0.125"))).To(BeTrue())
+		})
+
+		It("returns false if the amount has an infinite decimal representation", func() {
+			_, ok := third().Exact()
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	When("it is the zero-value", func() {
+		It("represents zero in the default currency", func() {
+			var r RatAmount
+			Expect(r.CurrencyCode()).To(Equal("USD"))
+			Expect(r.IsZero()).To(BeTrue())
+			Expect(r.Add(third()).EqualTo(third())).To(BeTrue())
+		})
+	})
+})
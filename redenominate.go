@@ -0,0 +1,89 @@
+package dosh
+
+import (
+	"fmt"
+
+	"github.com/dogmatiq/dosh/currency"
+)
+
+// Redenominate returns the amount expressed in the currency that succeeded a's
+// currency, such as converting an amount in Venezuelan bolívar fuerte ("VEF")
+// to Venezuelan bolívar soberano ("VES").
+//
+// The conversion uses the fixed rate at which the currency was replaced, as
+// given by currency.Info.SuccessorRate. The result is exact; no rounding is
+// performed. It returns an error if a's currency has no successor, or if the
+// exact result can not be represented as a decimal, which is the case for most
+// amounts in currencies that were replaced by the Euro at a non-decimal rate.
+// Use RedenominateWith() to round the result instead.
+//
+// Redenominate only performs a single step. To convert an amount through a
+// chain of redenominations, such as "VEB" to "VEF" to "VES", call it
+// repeatedly.
+func (a Amount) Redenominate() (Amount, error) {
+	i, err := successorOf(a)
+	if err != nil {
+		return Amount{}, err
+	}
+
+	m, ok := divExact(a.mag, i.SuccessorRate)
+	if !ok {
+		return Amount{}, fmt.Errorf(
+			"cannot redenominate %s amount to %s: %s / %s can not be represented exactly as a decimal",
+			i.Code,
+			i.Successor,
+			a.mag,
+			i.SuccessorRate,
+		)
+	}
+
+	return Amount{
+		cur: i.Successor,
+		mag: m,
+	}, nil
+}
+
+// RedenominateWith returns the amount expressed in the currency that
+// succeeded a's currency, rounded to n decimal places using the given rounding
+// mode.
+//
+// It is equivalent to Redenominate(), except that the result is rounded
+// rather than required to be exact. For example, conversions from the
+// currencies replaced by the Euro are legally rounded to the nearest cent,
+// which is achieved by calling RedenominateWith(2, HalfUp).
+//
+// It returns ErrInexact if mode is Unnecessary and the result can not be
+// represented exactly with n decimal places. It returns an error if a's
+// currency has no successor.
+func (a Amount) RedenominateWith(n int32, mode RoundingMode) (Amount, error) {
+	i, err := successorOf(a)
+	if err != nil {
+		return Amount{}, err
+	}
+
+	m, _, err := quoRem(a.mag, i.SuccessorRate, n, mode)
+	if err != nil {
+		return Amount{}, err
+	}
+
+	return Amount{
+		cur: i.Successor,
+		mag: m,
+	}, nil
+}
+
+// successorOf returns information about a's currency, which is guaranteed to
+// have a successor.
+func successorOf(a Amount) (currency.Info, error) {
+	c := a.CurrencyCode()
+	if c == "" {
+		return currency.Info{}, fmt.Errorf("cannot redenominate amount: %w", ErrNoCurrency)
+	}
+
+	i, ok := currency.Lookup(c)
+	if !ok || i.Successor == "" {
+		return currency.Info{}, fmt.Errorf("cannot redenominate %s amount: currency has no successor", c)
+	}
+
+	return i, nil
+}
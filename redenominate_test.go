@@ -0,0 +1,78 @@
+package dosh_test
+
+import (
+	. "github.com/dogmatiq/dosh"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("type Amount (redenomination)", func() {
+	Describe("func Redenominate()", func() {
+		DescribeTable(
+			"it returns the amount expressed in the successor currency",
+			func(a, expect Amount) {
+				r, err := a.Redenominate()
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(r.IdenticalTo(expect)).To(BeTrue(), "%s != %s", r, expect)
+			},
+			Entry("power of ten", FromString("VEF", "123456.78"), FromString("VES", "1.2345678")),
+			Entry("large power of ten", FromString("TRL", "1500000"), FromString("TRY", "1.5")),
+			Entry("non-power of ten", FromString("AZM", "12345"), FromString("AZN", "2.469")),
+			Entry("negative", FromString("VEF", "-100000"), FromString("VES", "-1")),
+			Entry("zero", Zero("TRL"), Zero("TRY")),
+			Entry("euro conversion with a terminating result", FromString("FRF", "65.5957"), FromString("EUR", "10")),
+		)
+
+		It("can be called repeatedly to follow a chain of redenominations", func() {
+			a := FromString("VEB", "100000000")
+
+			a, err := a.Redenominate()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(a.IdenticalTo(FromString("VEF", "100000"))).To(BeTrue())
+
+			a, err = a.Redenominate()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(a.IdenticalTo(FromString("VES", "1"))).To(BeTrue())
+		})
+
+		DescribeTable(
+			"it returns an error if the amount can not be redenominated",
+			func(a Amount, expect string) {
+				_, err := a.Redenominate()
+				Expect(err).To(MatchError(expect))
+			},
+			Entry("active currency", FromInt("USD", 1), "cannot redenominate USD amount: currency has no successor"),
+			Entry("unknown currency", FromInt("XYZ", 1), "cannot redenominate XYZ amount: currency has no successor"),
+			Entry("non-terminating result", FromInt("DEM", 1), "cannot redenominate DEM amount to EUR: 1 / 1.95583 can not be represented exactly as a decimal"),
+		)
+	})
+
+	Describe("func RedenominateWith()", func() {
+		DescribeTable(
+			"it returns the rounded amount expressed in the successor currency",
+			func(a Amount, n int32, mode RoundingMode, expect Amount) {
+				r, err := a.RedenominateWith(n, mode)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(r.IdenticalTo(expect)).To(BeTrue(), "%s != %s", r, expect)
+			},
+			Entry("euro conversion to the cent", FromInt("DEM", 1), int32(2), HalfUp, FromString("EUR", "0.51")),
+			Entry("euro conversion, half way", FromString("DEM", "0.02933745"), int32(2), HalfUp, FromString("EUR", "0.02")),
+			Entry("euro conversion, large amount", FromInt("ITL", 1000000), int32(2), HalfUp, FromString("EUR", "516.46")),
+			Entry("euro conversion, negative", FromInt("FRF", -100), int32(2), HalfUp, FromString("EUR", "-15.24")),
+			Entry("euro conversion, rounded down", FromInt("DEM", 1), int32(2), Down, FromString("EUR", "0.51")),
+			Entry("euro conversion, rounded up", FromInt("DEM", 1), int32(2), Up, FromString("EUR", "0.52")),
+			Entry("exact result", FromString("VEF", "100000"), int32(2), Unnecessary, FromInt("VES", 1)),
+		)
+
+		It("returns ErrInexact if the mode is Unnecessary and rounding is required", func() {
+			_, err := FromInt("DEM", 1).RedenominateWith(2, Unnecessary)
+			Expect(err).To(Equal(ErrInexact))
+		})
+
+		It("returns an error if the currency has no successor", func() {
+			_, err := FromInt("USD", 1).RedenominateWith(2, HalfUp)
+			Expect(err).To(MatchError("cannot redenominate USD amount: currency has no successor"))
+		})
+	})
+})
@@ -0,0 +1,250 @@
+package dosh
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/shopspring/decimal"
+)
+
+// Tender describes a payment method used to pay for an order, such as a gift
+// card or credit card, for use with PlanRefund().
+type Tender struct {
+	// Paid is the amount originally paid using the tender.
+	Paid Amount
+
+	// Refunded is the amount already refunded to the tender. The zero-value
+	// means that nothing has been refunded.
+	Refunded Amount
+}
+
+// RefundStrategy determines how a refund is spread across the tenders used to
+// pay for an order.
+//
+// Applications may implement their own strategies, for example to give
+// priority to particular payment methods.
+type RefundStrategy interface {
+	// Plan returns the number of minor units to refund to each tender.
+	//
+	// refund is the total number of minor units to refund, and available is
+	// the number of minor units that may still be refunded to each tender.
+	// The refund is never negative, and never exceeds the sum of the
+	// available units. Plan must not modify refund or available.
+	//
+	// The result must contain one element for each tender. Each element must
+	// be between zero and the tender's available units, and the elements must
+	// add up to exactly refund.
+	Plan(refund *big.Int, available []*big.Int) []*big.Int
+}
+
+var (
+	// ReverseOrder is a RefundStrategy that refunds the last tender first,
+	// then the second last, and so on, such that the tender that was used
+	// first is refunded last.
+	ReverseOrder RefundStrategy = reverseOrder{}
+
+	// Proportional is a RefundStrategy that spreads the refund across all
+	// tenders in proportion to the amount that may still be refunded to
+	// each, using the largest remainder method as per Amount.Allocate().
+	Proportional RefundStrategy = proportional{}
+)
+
+// TenderFirst returns a RefundStrategy that refunds as much as possible to the
+// tender at index i, then spreads any remaining refund across the other
+// tenders using the "then" strategy.
+//
+// If then is nil, ReverseOrder is used.
+func TenderFirst(i int, then RefundStrategy) RefundStrategy {
+	if then == nil {
+		then = ReverseOrder
+	}
+
+	return tenderFirst{i, then}
+}
+
+// PlanRefund returns the amount to refund to each of the tenders used to pay
+// for an order, such that the amounts add up to exactly refund.
+//
+// The refund is spread across the tenders according to the given strategy,
+// and never exceeds the amount that may still be refunded to each tender;
+// that is, the amount paid less the amount already refunded. The result
+// contains one amount for each tender, in the same order.
+//
+// It returns an error if refund is negative, if it exceeds the total amount
+// that may still be refunded, if any tender has been refunded more than was
+// paid, or if any amount is not a whole number of its currency's minor unit.
+// It panics if no tenders are provided, if the amounts do not all use the same
+// currency, if the strategy refers to a tender that does not exist, or if the
+// strategy returns an invalid plan.
+func PlanRefund(refund Amount, tenders []Tender, strategy RefundStrategy) ([]Amount, error) {
+	if len(tenders) == 0 {
+		panic("at least one tender must be provided")
+	}
+
+	parts, places, err := planRefund(refund, tenders, strategy)
+	if err != nil {
+		return nil, fmt.Errorf("cannot plan refund of %s: %w", refund.String(), err)
+	}
+
+	return fromMinorUnits(refund, parts, places, false), nil
+}
+
+func planRefund(refund Amount, tenders []Tender, strategy RefundStrategy) ([]*big.Int, int32, error) {
+	total, places, err := toMinorUnits(refund)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if total.Sign() < 0 {
+		return nil, 0, errors.New("refund is negative")
+	}
+
+	available := make([]*big.Int, len(tenders))
+	sum := new(big.Int)
+
+	for i, t := range tenders {
+		assertSameCurrency(refund, t.Paid)
+
+		// A zero-value Refunded amount is treated as zero in the refund's
+		// currency, even if it differs from the default currency.
+		refunded := t.Refunded
+		if !refunded.IsSet() {
+			refunded = Amount{cur: refund.cur}
+		}
+		assertSameCurrency(refund, refunded)
+
+		paid, _, err := toMinorUnits(t.Paid)
+		if err != nil {
+			return nil, 0, fmt.Errorf("amount paid by tender %d (%s) is invalid: %w", i, t.Paid.String(), err)
+		}
+
+		prior, _, err := toMinorUnits(refunded)
+		if err != nil {
+			return nil, 0, fmt.Errorf("amount refunded to tender %d (%s) is invalid: %w", i, refunded.String(), err)
+		}
+
+		if paid.Sign() < 0 || prior.Sign() < 0 {
+			return nil, 0, fmt.Errorf("tender %d has a negative amount", i)
+		}
+
+		if prior.Cmp(paid) > 0 {
+			return nil, 0, fmt.Errorf(
+				"amount refunded to tender %d (%s) exceeds the amount paid (%s)",
+				i,
+				refunded.String(),
+				t.Paid.String(),
+			)
+		}
+
+		available[i] = new(big.Int).Sub(paid, prior)
+		sum.Add(sum, available[i])
+	}
+
+	if total.Cmp(sum) > 0 {
+		return nil, 0, fmt.Errorf(
+			"refund exceeds the refundable balance (%s)",
+			Amount{cur: refund.cur, mag: decimal.NewFromBigInt(sum, -places)}.String(),
+		)
+	}
+
+	if strategy == nil {
+		strategy = ReverseOrder
+	}
+
+	return planWith(strategy, total, available), places, nil
+}
+
+// planWith returns the plan produced by the given strategy, and panics if it
+// is invalid.
+func planWith(strategy RefundStrategy, refund *big.Int, available []*big.Int) []*big.Int {
+	parts := strategy.Plan(refund, available)
+
+	if len(parts) != len(available) {
+		panic(fmt.Sprintf("refund strategy returned %d amounts, expected %d", len(parts), len(available)))
+	}
+
+	sum := new(big.Int)
+	for i, p := range parts {
+		if p == nil || p.Sign() < 0 || p.Cmp(available[i]) > 0 {
+			panic(fmt.Sprintf("refund strategy returned an invalid amount for tender %d (%v), expected between 0 and %s", i, p, available[i]))
+		}
+		sum.Add(sum, p)
+	}
+
+	if sum.Cmp(refund) != 0 {
+		panic(fmt.Sprintf("refund strategy returned amounts that add up to %s, expected %s", sum, refund))
+	}
+
+	return parts
+}
+
+type reverseOrder struct{}
+
+func (reverseOrder) Plan(refund *big.Int, available []*big.Int) []*big.Int {
+	parts := make([]*big.Int, len(available))
+	left := new(big.Int).Set(refund)
+
+	for i := len(available) - 1; i >= 0; i-- {
+		parts[i] = takeUpTo(left, available[i])
+	}
+
+	return parts
+}
+
+type proportional struct{}
+
+func (proportional) Plan(refund *big.Int, available []*big.Int) []*big.Int {
+	if refund.Sign() == 0 {
+		return zeros(len(available))
+	}
+
+	// Because the refund does not exceed the sum of the available units, no
+	// tender's share exceeds its available units, even after rounding.
+	return allocateUnits(refund, available)
+}
+
+type tenderFirst struct {
+	index int
+	then  RefundStrategy
+}
+
+func (s tenderFirst) Plan(refund *big.Int, available []*big.Int) []*big.Int {
+	if s.index < 0 || s.index >= len(available) {
+		panic(fmt.Sprintf("tender index (%d) is out of range, there are %d tenders", s.index, len(available)))
+	}
+
+	left := new(big.Int).Set(refund)
+	first := takeUpTo(left, available[s.index])
+
+	// Plan the remainder of the refund with the preferred tender's available
+	// units excluded.
+	others := make([]*big.Int, len(available))
+	copy(others, available)
+	others[s.index] = new(big.Int)
+
+	parts := planWith(s.then, left, others)
+	parts[s.index].Add(parts[s.index], first)
+
+	return parts
+}
+
+// takeUpTo subtracts min(left, available) from left, and returns it.
+func takeUpTo(left, available *big.Int) *big.Int {
+	n := new(big.Int).Set(available)
+	if left.Cmp(n) < 0 {
+		n.Set(left)
+	}
+
+	left.Sub(left, n)
+	return n
+}
+
+// zeros returns a slice of n zero-valued integers.
+func zeros(n int) []*big.Int {
+	z := make([]*big.Int, n)
+	for i := range z {
+		z[i] = new(big.Int)
+	}
+	return z
+}
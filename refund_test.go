@@ -0,0 +1,185 @@
+package dosh_test
+
+import (
+	"math/big"
+
+	. "github.com/dogmatiq/dosh"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+// inOrder is a RefundStrategy used to test custom strategies. It refunds the
+// first tender first, then the second, and so on.
+//
+// extra is added to the amount refunded to the first tender, producing an
+// invalid plan if it is non-zero.
+type inOrder struct {
+	extra int64
+}
+
+func (s inOrder) Plan(refund *big.Int, available []*big.Int) []*big.Int {
+	parts := make([]*big.Int, len(available))
+	left := new(big.Int).Set(refund)
+
+	for i, a := range available {
+		n := new(big.Int).Set(a)
+		if left.Cmp(n) < 0 {
+			n.Set(left)
+		}
+
+		left.Sub(left, n)
+		parts[i] = n
+	}
+
+	parts[0].Add(parts[0], big.NewInt(s.extra))
+
+	return parts
+}
+
+var _ = Describe("func PlanRefund()", func() {
+	// tenders are a gift card, a credit card and store credit, in the order
+	// they were used.
+	var tenders []Tender
+
+	BeforeEach(func() {
+		tenders = []Tender{
+			{Paid: FromInt("USD", 20)},
+			{Paid: FromInt("USD", 50), Refunded: FromInt("USD", 10)},
+			{Paid: FromInt("USD", 30)},
+		}
+	})
+
+	DescribeTable(
+		"it spreads the refund across the tenders",
+		func(refund string, strategy RefundStrategy, expect []string) {
+			r := FromString("USD", refund)
+			parts, err := PlanRefund(r, tenders, strategy)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(magnitudes(parts)).To(Equal(expect))
+			Expect(Sum(parts...).EqualTo(r)).To(BeTrue())
+		},
+		Entry("ReverseOrder, within the last tender", "25", ReverseOrder, []string{"0", "0", "25"}),
+		Entry("ReverseOrder, spanning tenders", "45", ReverseOrder, []string{"0", "15", "30"}),
+		Entry("ReverseOrder, respects prior refunds", "75", ReverseOrder, []string{"5", "40", "30"}),
+		Entry("nil strategy uses ReverseOrder", "45", nil, []string{"0", "15", "30"}),
+		Entry("Proportional", "45", Proportional, []string{"10", "20", "15"}),
+		Entry("Proportional, with rounding", "0.10", Proportional, []string{"0.02", "0.05", "0.03"}),
+		Entry("TenderFirst", "25", TenderFirst(0, nil), []string{"20", "0", "5"}),
+		Entry("TenderFirst, then Proportional", "30", TenderFirst(0, Proportional), []string{"20", "5.71", "4.29"}),
+		Entry("TenderFirst, nested", "65", TenderFirst(1, TenderFirst(0, nil)), []string{"20", "40", "5"}),
+		Entry("entire refundable balance", "90", Proportional, []string{"20", "40", "30"}),
+		Entry("zero refund", "0", Proportional, []string{"0", "0", "0"}),
+		Entry("custom strategy", "25", inOrder{}, []string{"20", "5", "0"}),
+		Entry("TenderFirst, then custom strategy", "45", TenderFirst(2, inOrder{}), []string{"15", "0", "30"}),
+	)
+
+	DescribeTable(
+		"it returns an error if the refund can not be planned",
+		func(refund string, tenders []Tender, expect string) {
+			_, err := PlanRefund(FromString("USD", refund), tenders, ReverseOrder)
+			Expect(err).To(MatchError(expect))
+		},
+		Entry(
+			"refund exceeds the refundable balance",
+			"100.01",
+			[]Tender{
+				{Paid: FromInt("USD", 100)},
+			},
+			"cannot plan refund of USD 100.01: refund exceeds the refundable balance (USD 100)",
+		),
+		Entry(
+			"refund exceeds the balance after prior refunds",
+			"50",
+			[]Tender{
+				{Paid: FromInt("USD", 100), Refunded: FromInt("USD", 60)},
+			},
+			"cannot plan refund of USD 50: refund exceeds the refundable balance (USD 40)",
+		),
+		Entry(
+			"prior refund exceeds the amount paid",
+			"1",
+			[]Tender{
+				{Paid: FromInt("USD", 10), Refunded: FromInt("USD", 20)},
+			},
+			"cannot plan refund of USD 1: amount refunded to tender 0 (USD 20) exceeds the amount paid (USD 10)",
+		),
+		Entry(
+			"negative refund",
+			"-1",
+			[]Tender{
+				{Paid: FromInt("USD", 10)},
+			},
+			"cannot plan refund of USD -1: refund is negative",
+		),
+		Entry(
+			"negative tender",
+			"1",
+			[]Tender{
+				{Paid: FromInt("USD", -10)},
+			},
+			"cannot plan refund of USD 1: tender 0 has a negative amount",
+		),
+		Entry(
+			"refund is not a whole number of minor units",
+			"1.005",
+			[]Tender{
+				{Paid: FromInt("USD", 10)},
+			},
+			"cannot plan refund of USD 1.005: amount is not a whole number of minor units",
+		),
+		Entry(
+			"tender is not a whole number of minor units",
+			"1",
+			[]Tender{
+				{Paid: FromString("USD", "10.005")},
+			},
+			"cannot plan refund of USD 1: amount paid by tender 0 (USD 10.005) is invalid: amount is not a whole number of minor units",
+		),
+	)
+
+	It("treats a zero-value refunded amount as zero in the refund's currency", func() {
+		parts, err := PlanRefund(
+			FromInt("EUR", 5),
+			[]Tender{{Paid: FromInt("EUR", 10)}},
+			ReverseOrder,
+		)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(parts[0].IdenticalTo(FromInt("EUR", 5))).To(BeTrue())
+	})
+
+	It("panics if no tenders are provided", func() {
+		Expect(func() {
+			PlanRefund(FromInt("USD", 1), nil, ReverseOrder)
+		}).To(PanicWith("at least one tender must be provided"))
+	})
+
+	It("panics if the tenders do not use the same currency as the refund", func() {
+		Expect(func() {
+			PlanRefund(
+				FromInt("USD", 1),
+				[]Tender{{Paid: FromInt("EUR", 10)}},
+				ReverseOrder,
+			)
+		}).To(PanicWith("can not operate on amounts in differing currencies (USD vs EUR)"))
+	})
+
+	DescribeTable(
+		"it panics if the strategy returns an invalid plan",
+		func(refund string, strategy RefundStrategy, expect string) {
+			Expect(func() {
+				PlanRefund(FromString("USD", refund), tenders, strategy)
+			}).To(PanicWith(expect))
+		},
+		Entry("amount exceeds the available units", "25", inOrder{extra: 1}, "refund strategy returned an invalid amount for tender 0 (2001), expected between 0 and 2000"),
+		Entry("negative amount", "0", inOrder{extra: -1}, "refund strategy returned an invalid amount for tender 0 (-1), expected between 0 and 2000"),
+		Entry("amounts do not add up to the refund", "5", inOrder{extra: 1}, "refund strategy returned amounts that add up to 501, expected 500"),
+		Entry("nested within TenderFirst", "5", TenderFirst(2, inOrder{extra: 1}), "refund strategy returned amounts that add up to 1, expected 0"),
+	)
+
+	It("panics if the preferred tender does not exist", func() {
+		Expect(func() {
+			PlanRefund(FromInt("USD", 1), tenders, TenderFirst(3, nil))
+		}).To(PanicWith("tender index (3) is out of range, there are 3 tenders"))
+	})
+})
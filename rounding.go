@@ -1,8 +1,15 @@
 package dosh
 
+import (
+	"github.com/dogmatiq/dosh/internal/round"
+	"github.com/dogmatiq/dosh/rounding"
+	"github.com/shopspring/decimal"
+)
+
 // Floor returns an amount with a magnitude equal to the nearest integer less
 // than or equal to a.Magnitude().
 func (a Amount) Floor() Amount {
+	assertHasCurrency(a)
 	a.mag = a.mag.Floor()
 	return a
 }
@@ -10,6 +17,7 @@ func (a Amount) Floor() Amount {
 // Ceil returns an amount with a magnitude equal to the the nearest integer
 // greater than or equal to a.Magnitude().
 func (a Amount) Ceil() Amount {
+	assertHasCurrency(a)
 	a.mag = a.mag.Ceil()
 	return a
 }
@@ -19,6 +27,7 @@ func (a Amount) Ceil() Amount {
 //
 // n must be positive.
 func (a Amount) Truncate(n int32) Amount {
+	assertHasCurrency(a)
 	a.mag = a.mag.Truncate(n)
 	return a
 }
@@ -32,6 +41,7 @@ func (a Amount) Truncate(n int32) Amount {
 // example, an amount with a magnitude of 543 rounded to -1 places results in an
 // amount with a magnitude of 540.
 func (a Amount) Round(n int32) Amount {
+	assertHasCurrency(a)
 	a.mag = a.mag.Round(n)
 	return a
 }
@@ -46,6 +56,111 @@ func (a Amount) Round(n int32) Amount {
 // example, an amount with a magnitude of 543 rounded to -1 places results in an
 // amount with a magnitude of 540.
 func (a Amount) RoundBank(n int32) Amount {
+	assertHasCurrency(a)
 	a.mag = a.mag.RoundBank(n)
 	return a
 }
+
+// RoundWith returns the amount rounded to n decimal places using the given
+// rounding mode.
+//
+// If n is negative the result is rounded to the -n'th integer place. For
+// example, an amount with a magnitude of 543 rounded to -1 places results in an
+// amount with a magnitude of 540.
+//
+// It returns ErrInexact if mode is Unnecessary and the amount can not be
+// represented exactly with n decimal places. No other mode causes an error.
+func (a Amount) RoundWith(n int32, mode RoundingMode) (Amount, error) {
+	assertHasCurrency(a)
+
+	m, err := roundDecimal(a.mag, n, mode)
+	if err != nil {
+		return Amount{}, err
+	}
+
+	a.mag = m
+	return a, nil
+}
+
+// roundDecimal returns d rounded to n decimal places using the given rounding
+// mode.
+func roundDecimal(d decimal.Decimal, n int32, mode RoundingMode) (decimal.Decimal, error) {
+	// Shift the decimal point such that the digits to be discarded are
+	// entirely within the fractional part.
+	s := d.Shift(n)
+	t := s.Truncate(0)
+	r := s.Sub(t)
+
+	if r.IsZero() {
+		return d, nil
+	}
+
+	neg := s.IsNegative()
+	odd := !t.Mod(two).IsZero()
+
+	away, err := round.Away(mode, neg, r.Abs().Cmp(half), odd)
+	if err != nil {
+		return decimal.Decimal{}, err
+	}
+
+	if away {
+		if neg {
+			t = t.Sub(unit)
+		} else {
+			t = t.Add(unit)
+		}
+	}
+
+	return t.Shift(-n), nil
+}
+
+// roundToIncrement returns d rounded to an integer multiple of step using the
+// given rounding mode. step must be positive.
+func roundToIncrement(d, step decimal.Decimal, mode RoundingMode) (decimal.Decimal, error) {
+	q, r := d.QuoRem(step, 0)
+
+	if r.IsZero() {
+		return d, nil
+	}
+
+	neg := d.IsNegative()
+	odd := !q.Mod(two).IsZero()
+
+	away, err := round.Away(mode, neg, r.Abs().Mul(two).Cmp(step), odd)
+	if err != nil {
+		return decimal.Decimal{}, err
+	}
+
+	if away {
+		if neg {
+			q = q.Sub(unit)
+		} else {
+			q = q.Add(unit)
+		}
+	}
+
+	return q.Mul(step), nil
+}
+
+// RoundToIncrement returns the amount rounded to an integer multiple of step
+// using the given rounding mode.
+//
+// For example, an amount with a magnitude of 1.13 rounded to an increment of
+// 0.25 using the HalfUp mode results in an amount with a magnitude of 1.25.
+//
+// It returns ErrInexact if mode is Unnecessary and the amount is not already a
+// multiple of step. It panics if step is not positive.
+func (a Amount) RoundToIncrement(step decimal.Decimal, mode RoundingMode) (Amount, error) {
+	assertHasCurrency(a)
+	if err := rounding.ValidateIncrement(step); err != nil {
+		panic(err)
+	}
+
+	m, err := roundToIncrement(a.mag, step, mode)
+	if err != nil {
+		return Amount{}, err
+	}
+
+	a.mag = m
+	return a, nil
+}
@@ -0,0 +1,8 @@
+// Package rounding defines the rounding modes and price point rules that are
+// shared by dosh amounts and the protomoney package.
+//
+// It has no dependencies on the rest of dosh, allowing low-level packages such
+// as protomoney to round values without depending on the dosh package itself.
+// The dosh package re-exports its types and values, so most applications do
+// not need to import this package directly.
+package rounding
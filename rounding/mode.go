@@ -0,0 +1,78 @@
+package rounding
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Mode is a strategy for rounding a value that can not be represented exactly
+// at the required precision.
+type Mode int
+
+const (
+	// HalfUp rounds towards the nearest neighbor, unless both neighbors are
+	// equidistant, in which case it rounds away from zero. It is also known as
+	// "commercial rounding", and is the strategy used by dosh.Amount.Round().
+	HalfUp Mode = iota
+
+	// HalfDown rounds towards the nearest neighbor, unless both neighbors are
+	// equidistant, in which case it rounds towards zero.
+	HalfDown
+
+	// HalfEven rounds towards the nearest neighbor, unless both neighbors are
+	// equidistant, in which case it rounds towards the even neighbor. It is
+	// also known as "banker's rounding", and is the strategy used by
+	// dosh.Amount.RoundBank().
+	HalfEven
+
+	// HalfOdd rounds towards the nearest neighbor, unless both neighbors are
+	// equidistant, in which case it rounds towards the odd neighbor.
+	HalfOdd
+
+	// Up rounds away from zero.
+	Up
+
+	// Down rounds towards zero. It is the strategy used by
+	// dosh.Amount.Truncate().
+	Down
+
+	// Ceiling rounds towards positive infinity.
+	Ceiling
+
+	// Floor rounds towards negative infinity.
+	Floor
+
+	// Unnecessary asserts that no rounding is necessary. Any operation that
+	// would need to round the value fails with ErrInexact instead.
+	Unnecessary
+)
+
+// ErrInexact is returned when the result of an operation can not be
+// represented exactly and rounding is either disabled or not permitted.
+var ErrInexact = errors.New("result can not be represented exactly at the required precision")
+
+// String returns the name of the rounding mode.
+func (m Mode) String() string {
+	switch m {
+	case HalfUp:
+		return "HalfUp"
+	case HalfDown:
+		return "HalfDown"
+	case HalfEven:
+		return "HalfEven"
+	case HalfOdd:
+		return "HalfOdd"
+	case Up:
+		return "Up"
+	case Down:
+		return "Down"
+	case Ceiling:
+		return "Ceiling"
+	case Floor:
+		return "Floor"
+	case Unnecessary:
+		return "Unnecessary"
+	default:
+		return fmt.Sprintf("RoundingMode(%d)", int(m))
+	}
+}
@@ -0,0 +1,29 @@
+package rounding_test
+
+import (
+	. "github.com/dogmatiq/dosh/rounding"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("type Mode", func() {
+	Describe("func String()", func() {
+		DescribeTable(
+			"it returns the name of the rounding mode",
+			func(m Mode, expect string) {
+				Expect(m.String()).To(Equal(expect))
+			},
+			Entry("HalfUp", HalfUp, "HalfUp"),
+			Entry("HalfDown", HalfDown, "HalfDown"),
+			Entry("HalfEven", HalfEven, "HalfEven"),
+			Entry("HalfOdd", HalfOdd, "HalfOdd"),
+			Entry("Up", Up, "Up"),
+			Entry("Down", Down, "Down"),
+			Entry("Ceiling", Ceiling, "Ceiling"),
+			Entry("Floor", Floor, "Floor"),
+			Entry("Unnecessary", Unnecessary, "Unnecessary"),
+			Entry("unrecognized", Mode(100), "RoundingMode(100)"),
+		)
+	})
+})
@@ -0,0 +1,53 @@
+package rounding
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// PricePoint is a rule that describes a set of "psychological" prices, such as
+// those ending in .99.
+//
+// The prices described by a rule are k × Step + Ending, for each non-negative
+// integer k. For example, a rule with a Step of 1 and an Ending of 0.99
+// describes the prices 0.99, 1.99, 2.99, and so on, whereas a rule with a Step
+// of 10 and an Ending of 9 describes the prices 9, 19, 29, and so on.
+//
+// Price points describe the magnitude of an amount, such that the same rule
+// also describes the negative prices -0.99, -1.99, -2.99, and so on.
+type PricePoint struct {
+	// Step is the interval between successive prices. It must be positive.
+	Step decimal.Decimal
+
+	// Ending is the amount by which each price exceeds a multiple of Step. It
+	// must be non-negative and less than Step.
+	Ending decimal.Decimal
+}
+
+// Validate returns an error if p is not a valid price point rule.
+func (p PricePoint) Validate() error {
+	if err := ValidateIncrement(p.Step); err != nil {
+		return err
+	}
+
+	if p.Ending.IsNegative() || p.Ending.GreaterThanOrEqual(p.Step) {
+		return fmt.Errorf(
+			"price point ending (%s) must be non-negative and less than the step (%s)",
+			p.Ending,
+			p.Step,
+		)
+	}
+
+	return nil
+}
+
+// ValidateIncrement returns an error if step is not a valid rounding
+// increment; that is, if it is not positive.
+func ValidateIncrement(step decimal.Decimal) error {
+	if !step.IsPositive() {
+		return fmt.Errorf("rounding increment (%s) must be positive", step)
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package rounding_test
+
+import (
+	. "github.com/dogmatiq/dosh/rounding"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/shopspring/decimal"
+)
+
+var _ = Describe("type PricePoint", func() {
+	Describe("func Validate()", func() {
+		It("returns nil if the rule is valid", func() {
+			p := PricePoint{
+				Step:   decimal.NewFromInt(1),
+				Ending: decimal.RequireFromString("0.99"),
+			}
+			Expect(p.Validate()).To(Succeed())
+		})
+
+		DescribeTable(
+			"it returns an error if the rule is invalid",
+			func(step, ending, expect string) {
+				p := PricePoint{
+					Step:   decimal.RequireFromString(step),
+					Ending: decimal.RequireFromString(ending),
+				}
+				Expect(p.Validate()).To(MatchError(expect))
+			},
+			Entry("zero step", "0", "0", "rounding increment (0) must be positive"),
+			Entry("negative step", "-1", "0", "rounding increment (-1) must be positive"),
+			Entry("negative ending", "1", "-0.01", "price point ending (-0.01) must be non-negative and less than the step (1)"),
+			Entry("ending equal to the step", "1", "1", "price point ending (1) must be non-negative and less than the step (1)"),
+		)
+	})
+})
+
+var _ = Describe("func ValidateIncrement()", func() {
+	It("returns nil if the increment is positive", func() {
+		Expect(ValidateIncrement(decimal.RequireFromString("0.05"))).To(Succeed())
+	})
+
+	It("returns an error if the increment is not positive", func() {
+		Expect(ValidateIncrement(decimal.Zero)).To(MatchError("rounding increment (0) must be positive"))
+	})
+})
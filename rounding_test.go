@@ -5,6 +5,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
+	"github.com/shopspring/decimal"
 )
 
 var _ = Describe("type Amount (rounding methods)", func() {
@@ -135,4 +136,112 @@ var _ = Describe("type Amount (rounding methods)", func() {
 			Entry("negative (half, odd)", "-115", "-120"),
 		)
 	})
+
+	Describe("func RoundWith()", func() {
+		DescribeTable(
+			"it returns an amount with the magnitude rounded using the given mode",
+			func(mode RoundingMode, a, expect string) {
+				r, err := FromString("XYZ", a).RoundWith(0, mode)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(r.EqualTo(FromString("XYZ", expect))).To(
+					BeTrue(),
+					"%s rounded to %s, expected %s",
+					a,
+					r.Magnitude(),
+					expect,
+				)
+			},
+			Entry("HalfUp, below half", HalfUp, "2.4", "2"),
+			Entry("HalfUp, half", HalfUp, "2.5", "3"),
+			Entry("HalfUp, above half", HalfUp, "2.6", "3"),
+			Entry("HalfUp, negative half", HalfUp, "-2.5", "-3"),
+			Entry("HalfDown, below half", HalfDown, "2.4", "2"),
+			Entry("HalfDown, half", HalfDown, "2.5", "2"),
+			Entry("HalfDown, above half", HalfDown, "2.6", "3"),
+			Entry("HalfDown, negative half", HalfDown, "-2.5", "-2"),
+			Entry("HalfEven, half with even neighbor below", HalfEven, "2.5", "2"),
+			Entry("HalfEven, half with even neighbor above", HalfEven, "3.5", "4"),
+			Entry("HalfEven, negative half", HalfEven, "-2.5", "-2"),
+			Entry("HalfEven, above half", HalfEven, "2.51", "3"),
+			Entry("HalfOdd, half with odd neighbor above", HalfOdd, "2.5", "3"),
+			Entry("HalfOdd, half with odd neighbor below", HalfOdd, "3.5", "3"),
+			Entry("HalfOdd, negative half", HalfOdd, "-2.5", "-3"),
+			Entry("HalfOdd, below half", HalfOdd, "3.49", "3"),
+			Entry("Up, positive", Up, "2.1", "3"),
+			Entry("Up, negative", Up, "-2.1", "-3"),
+			Entry("Down, positive", Down, "2.9", "2"),
+			Entry("Down, negative", Down, "-2.9", "-2"),
+			Entry("Ceiling, positive", Ceiling, "2.1", "3"),
+			Entry("Ceiling, negative", Ceiling, "-2.9", "-2"),
+			Entry("Floor, positive", Floor, "2.9", "2"),
+			Entry("Floor, negative", Floor, "-2.1", "-3"),
+			Entry("Unnecessary, exact", Unnecessary, "2.000", "2"),
+		)
+
+		DescribeTable(
+			"it rounds to the given number of decimal places",
+			func(n int32, a, expect string) {
+				r, err := FromString("XYZ", a).RoundWith(n, HalfEven)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(r.EqualTo(FromString("XYZ", expect))).To(BeTrue())
+			},
+			Entry("positive places", int32(2), "1.125", "1.12"),
+			Entry("zero places", int32(0), "1.5", "2"),
+			Entry("negative places", int32(-1), "545", "540"),
+			Entry("more places than the magnitude", int32(4), "1.25", "1.25"),
+		)
+
+		It("returns ErrInexact if the mode is Unnecessary and rounding is required", func() {
+			_, err := FromString("XYZ", "1.25").RoundWith(1, Unnecessary)
+			Expect(err).To(Equal(ErrInexact))
+		})
+
+		It("preserves the currency", func() {
+			r, err := FromString("XYZ", "1.25").RoundWith(1, Up)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(r.CurrencyCode()).To(Equal("XYZ"))
+		})
+
+		It("panics if the rounding mode is not recognized", func() {
+			Expect(func() {
+				FromString("XYZ", "1.25").RoundWith(1, RoundingMode(100))
+			}).To(PanicWith("unrecognized rounding mode (100)"))
+		})
+	})
+
+	Describe("func RoundToIncrement()", func() {
+		DescribeTable(
+			"it returns an amount with the magnitude rounded to a multiple of the increment",
+			func(step string, mode RoundingMode, a, expect string) {
+				r, err := FromString("XYZ", a).RoundToIncrement(decimal.RequireFromString(step), mode)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(r.EqualTo(FromString("XYZ", expect))).To(
+					BeTrue(),
+					"%s rounded to %s, expected %s",
+					a,
+					r.Magnitude(),
+					expect,
+				)
+			},
+			Entry("quarter, nearest", "0.25", HalfUp, "1.13", "1.25"),
+			Entry("quarter, half", "0.25", HalfUp, "1.125", "1.25"),
+			Entry("quarter, half (banker's)", "0.25", HalfEven, "1.125", "1.00"),
+			Entry("quarter, negative", "0.25", HalfUp, "-1.13", "-1.25"),
+			Entry("five, ceiling", "5", Ceiling, "11", "15"),
+			Entry("five, floor", "5", Floor, "-11", "-15"),
+			Entry("five, down", "5", Down, "-14.99", "-10"),
+			Entry("already a multiple", "5", Unnecessary, "15", "15"),
+		)
+
+		It("returns ErrInexact if the mode is Unnecessary and rounding is required", func() {
+			_, err := FromString("XYZ", "1.13").RoundToIncrement(decimal.RequireFromString("0.25"), Unnecessary)
+			Expect(err).To(Equal(ErrInexact))
+		})
+
+		It("panics if the increment is not positive", func() {
+			Expect(func() {
+				FromString("XYZ", "1").RoundToIncrement(decimal.Zero, HalfUp)
+			}).To(PanicWith(MatchError("rounding increment (0) must be positive")))
+		})
+	})
 })
@@ -0,0 +1,28 @@
+package dosh
+
+import "github.com/dogmatiq/dosh/rounding"
+
+// RoundingMode is a strategy for rounding a value that can not be represented
+// exactly at the required precision.
+//
+// It is an alias for rounding.Mode, such that the same modes can be used with
+// both Amount and the protomoney package.
+type RoundingMode = rounding.Mode
+
+// The rounding modes, as defined by the rounding package. See rounding.Mode
+// for a description of each mode.
+const (
+	HalfUp      = rounding.HalfUp
+	HalfDown    = rounding.HalfDown
+	HalfEven    = rounding.HalfEven
+	HalfOdd     = rounding.HalfOdd
+	Up          = rounding.Up
+	Down        = rounding.Down
+	Ceiling     = rounding.Ceiling
+	Floor       = rounding.Floor
+	Unnecessary = rounding.Unnecessary
+)
+
+// ErrInexact is returned when the result of an operation can not be
+// represented exactly and rounding is either disabled or not permitted.
+var ErrInexact = rounding.ErrInexact
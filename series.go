@@ -0,0 +1,101 @@
+package dosh
+
+// RoundingAccumulator rounds a series of amounts to the precision of their
+// currency's minor unit, carrying the rounding residual forward from one
+// amount to the next.
+//
+// Rounding each amount independently can produce rounded values that do not
+// add up to the rounded sum of the original amounts. Instead, the accumulator
+// rounds the running total of the series, and each rounded amount is the
+// difference between successive rounded totals. Hence the sum of the rounded
+// amounts is always equal to the rounded sum of the original amounts.
+//
+// The zero-value is an empty accumulator that uses the HalfUp rounding mode.
+// All amounts in the series must use the same currency.
+//
+// A RoundingAccumulator is not safe for concurrent use.
+type RoundingAccumulator struct {
+	// Mode is the rounding mode used to round the running total.
+	Mode RoundingMode
+
+	exact   Amount
+	rounded Amount
+	started bool
+}
+
+// Round adds a to the series and returns its rounded value.
+//
+// adjustment is the difference between the rounded value and a, such that
+// a.Add(adjustment) is equal to rounded.
+//
+// It returns an error under the same conditions as Amount.RoundToMinorUnit(),
+// in which case a is not added to the series. It panics if a does not use the
+// same currency as the amounts already in the series.
+func (r *RoundingAccumulator) Round(a Amount) (rounded, adjustment Amount, err error) {
+	exact := a
+	if r.started {
+		exact = r.exact.Add(a)
+	}
+
+	total, err := exact.RoundToMinorUnit(r.Mode)
+	if err != nil {
+		return Amount{}, Amount{}, err
+	}
+
+	rounded = total
+	if r.started {
+		rounded = total.Sub(r.rounded)
+	}
+
+	r.exact = exact
+	r.rounded = total
+	r.started = true
+
+	return rounded, rounded.Sub(a), nil
+}
+
+// Total returns the sum of the rounded amounts in the series, which is equal
+// to the rounded sum of the original amounts.
+//
+// ok is false if the series is empty.
+func (r *RoundingAccumulator) Total() (_ Amount, ok bool) {
+	return r.rounded, r.started
+}
+
+// Residual returns the difference between the sum of the original amounts in
+// the series and the sum of the rounded amounts.
+//
+// The residual is the portion of the series that has not yet been "paid out"
+// by rounding, and is carried forward into the next amount. ok is false if
+// the series is empty.
+func (r *RoundingAccumulator) Residual() (_ Amount, ok bool) {
+	if !r.started {
+		return Amount{}, false
+	}
+
+	return r.exact.Sub(r.rounded), true
+}
+
+// RoundSeries rounds a series of amounts to the precision of their currency's
+// minor unit using a RoundingAccumulator, such that the sum of the rounded
+// amounts is equal to the rounded sum of the original amounts.
+//
+// adjustments[i] is the difference between rounded[i] and amounts[i].
+//
+// It returns an error under the same conditions as Amount.RoundToMinorUnit().
+// It panics if the amounts do not use the same currency.
+func RoundSeries(mode RoundingMode, amounts ...Amount) (rounded, adjustments []Amount, err error) {
+	acc := RoundingAccumulator{Mode: mode}
+
+	rounded = make([]Amount, len(amounts))
+	adjustments = make([]Amount, len(amounts))
+
+	for i, a := range amounts {
+		rounded[i], adjustments[i], err = acc.Round(a)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return rounded, adjustments, nil
+}
@@ -0,0 +1,139 @@
+package dosh_test
+
+import (
+	. "github.com/dogmatiq/dosh"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("type RoundingAccumulator", func() {
+	var acc *RoundingAccumulator
+
+	BeforeEach(func() {
+		acc = &RoundingAccumulator{}
+	})
+
+	Describe("func Round()", func() {
+		It("carries the residual forward to subsequent amounts", func() {
+			var rounded, adjustments []string
+
+			for i := 0; i < 3; i++ {
+				r, adj, err := acc.Round(FromString("USD", "0.333"))
+				Expect(err).ShouldNot(HaveOccurred())
+				rounded = append(rounded, r.String())
+				adjustments = append(adjustments, adj.String())
+			}
+
+			Expect(rounded).To(Equal([]string{"USD 0.33", "USD 0.34", "USD 0.33"}))
+			Expect(adjustments).To(Equal([]string{"USD -0.003", "USD 0.007", "USD -0.003"}))
+		})
+
+		It("uses the configured rounding mode", func() {
+			acc.Mode = Floor
+
+			r, _, err := acc.Round(FromString("USD", "0.019"))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(r.EqualTo(FromString("USD", "0.01"))).To(BeTrue())
+
+			r, _, err = acc.Round(FromString("USD", "0.019"))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(r.EqualTo(FromString("USD", "0.02"))).To(BeTrue())
+		})
+
+		It("returns an error and ignores the amount if it can not be rounded", func() {
+			_, _, err := acc.Round(FromString("XAU", "1"))
+			Expect(err).To(MatchError("cannot round XAU amount to its minor unit: currency has no minor unit"))
+
+			_, ok := acc.Total()
+			Expect(ok).To(BeFalse())
+		})
+
+		It("panics if the amounts do not use the same currency", func() {
+			_, _, err := acc.Round(Unit("USD"))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(func() {
+				acc.Round(Unit("EUR"))
+			}).To(PanicWith("can not operate on amounts in differing currencies (USD vs EUR)"))
+		})
+	})
+
+	Describe("func Total()", func() {
+		It("returns the rounded sum of the amounts", func() {
+			acc.Round(FromString("JPY", "10.4"))
+			acc.Round(FromString("JPY", "10.4"))
+			acc.Round(FromString("JPY", "10.4"))
+
+			t, ok := acc.Total()
+			Expect(ok).To(BeTrue())
+			Expect(t.EqualTo(FromString("JPY", "31"))).To(BeTrue())
+		})
+
+		It("returns false if the series is empty", func() {
+			_, ok := acc.Total()
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	Describe("func Residual()", func() {
+		It("returns the difference between the exact and rounded sums", func() {
+			acc.Round(FromString("USD", "0.333"))
+			acc.Round(FromString("USD", "0.333"))
+
+			r, ok := acc.Residual()
+			Expect(ok).To(BeTrue())
+			Expect(r.EqualTo(FromString("USD", "-0.004"))).To(BeTrue())
+		})
+
+		It("returns false if the series is empty", func() {
+			_, ok := acc.Residual()
+			Expect(ok).To(BeFalse())
+		})
+	})
+})
+
+var _ = Describe("func RoundSeries()", func() {
+	It("returns rounded amounts that add up to the rounded sum", func() {
+		amounts := []Amount{
+			FromString("USD", "1.005"),
+			FromString("USD", "1.005"),
+			FromString("USD", "1.005"),
+			FromString("USD", "1.005"),
+		}
+
+		rounded, adjustments, err := RoundSeries(HalfEven, amounts...)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		expect, err := SumRounded(HalfEven, amounts...)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(Sum(rounded...).EqualTo(expect)).To(BeTrue())
+
+		for i, a := range amounts {
+			Expect(a.Add(adjustments[i]).EqualTo(rounded[i])).To(BeTrue())
+			Expect(rounded[i].IsMinorUnitExact()).To(BeTrue())
+		}
+	})
+
+	It("handles negative amounts", func() {
+		rounded, _, err := RoundSeries(
+			HalfUp,
+			FromString("USD", "-0.335"),
+			FromString("USD", "0.335"),
+		)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(rounded[0].EqualTo(FromString("USD", "-0.34"))).To(BeTrue())
+		Expect(rounded[1].EqualTo(FromString("USD", "0.34"))).To(BeTrue())
+	})
+
+	It("returns empty slices if there are no amounts", func() {
+		rounded, adjustments, err := RoundSeries(HalfUp)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(rounded).To(BeEmpty())
+		Expect(adjustments).To(BeEmpty())
+	})
+
+	It("returns an error if an amount can not be rounded", func() {
+		_, _, err := RoundSeries(Unnecessary, FromString("USD", "0.333"))
+		Expect(err).To(Equal(ErrInexact))
+	})
+})
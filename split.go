@@ -0,0 +1,155 @@
+package dosh
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// SplitStrategy determines which parts receive the minor units that are left
+// over when an amount is split into parts of equal size.
+type SplitStrategy interface {
+	// Order returns the indices of n parts, in the order in which they
+	// receive any leftover minor units.
+	//
+	// The result must be a permutation of the integers in [0, n).
+	Order(n int) []int
+}
+
+var (
+	// FirstParts is a SplitStrategy that distributes leftover minor units to
+	// the first parts. For example, splitting a USD amount of 100 into 3 parts
+	// results in the parts 33.34, 33.33 and 33.33.
+	FirstParts SplitStrategy = firstParts{}
+
+	// LastParts is a SplitStrategy that distributes leftover minor units to
+	// the last parts. For example, splitting a USD amount of 100 into 3 parts
+	// results in the parts 33.33, 33.33 and 33.34.
+	LastParts SplitStrategy = lastParts{}
+)
+
+// SeededParts returns a SplitStrategy that distributes leftover minor units to
+// parts chosen by a pseudo-random permutation derived from seed.
+//
+// The same seed always produces the same permutation, such that the split is
+// reproducible, for example by using an order ID as the seed.
+func SeededParts(seed uint64) SplitStrategy {
+	return seededParts{seed}
+}
+
+// Split divides the amount into n parts of equal size, such that the parts add
+// up to exactly the original amount.
+//
+// Each part is a whole number of the currency's minor unit. Any minor units
+// left over after dividing the amount evenly are distributed one at a time to
+// the parts chosen by the strategy. If strategy is nil, FirstParts is used.
+//
+// A negative amount is split as though it were positive, and then each of the
+// parts is negated, such that the leftover minor units are distributed in the
+// same way for refunds as for payments.
+//
+// It returns an error if the currency has no minor unit, if the amount is not
+// a whole number of minor units, or if the amount is non-zero but smaller than
+// n minor units, such that a part would be less than a single minor unit. It
+// panics if n is not positive.
+func (a Amount) Split(n int, strategy SplitStrategy) ([]Amount, error) {
+	if n <= 0 {
+		panic(fmt.Sprintf("number of parts (%d) must be positive", n))
+	}
+
+	if strategy == nil {
+		strategy = FirstParts
+	}
+
+	units, places, err := toMinorUnits(a)
+	if err != nil {
+		return nil, fmt.Errorf("cannot split %s into %d parts: %w", describe(a), n, err)
+	}
+
+	total := new(big.Int).Abs(units)
+	count := big.NewInt(int64(n))
+
+	if total.Sign() != 0 && total.Cmp(count) < 0 {
+		return nil, fmt.Errorf(
+			"cannot split %s into %d parts: each part would be less than the minor unit",
+			describe(a),
+			n,
+		)
+	}
+
+	q, r := new(big.Int).QuoRem(total, count, new(big.Int))
+
+	parts := make([]*big.Int, n)
+	for i := range parts {
+		parts[i] = new(big.Int).Set(q)
+	}
+
+	order := strategy.Order(n)
+	assertPermutation(order, n)
+
+	for _, i := range order[:r.Int64()] {
+		parts[i].Add(parts[i], bigOne)
+	}
+
+	return fromMinorUnits(a, parts, places, units.Sign() < 0), nil
+}
+
+// assertPermutation panics if order is not a permutation of the integers in
+// [0, n).
+func assertPermutation(order []int, n int) {
+	if len(order) != n {
+		panic(fmt.Sprintf("split strategy returned %d indices, expected %d", len(order), n))
+	}
+
+	seen := make([]bool, n)
+	for _, i := range order {
+		if i < 0 || i >= n || seen[i] {
+			panic(fmt.Sprintf("split strategy returned an invalid permutation (%v)", order))
+		}
+		seen[i] = true
+	}
+}
+
+type firstParts struct{}
+
+func (firstParts) Order(n int) []int {
+	order := make([]int, n)
+	for i := range order {
+		order[i] = i
+	}
+	return order
+}
+
+type lastParts struct{}
+
+func (lastParts) Order(n int) []int {
+	order := make([]int, n)
+	for i := range order {
+		order[i] = n - 1 - i
+	}
+	return order
+}
+
+type seededParts struct {
+	seed uint64
+}
+
+func (s seededParts) Order(n int) []int {
+	order := firstParts{}.Order(n)
+
+	// Shuffle using the Fisher-Yates algorithm with a splitmix64 generator.
+	// Both are implemented here, rather than using math/rand, so that the
+	// permutation for a given seed never changes between Go versions.
+	state := s.seed
+	for i := n - 1; i > 0; i-- {
+		state += 0x9e3779b97f4a7c15
+		z := state
+		z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+		z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+		z ^= z >> 31
+
+		j := int(z % uint64(i+1))
+		order[i], order[j] = order[j], order[i]
+	}
+
+	return order
+}
@@ -0,0 +1,125 @@
+package dosh_test
+
+import (
+	. "github.com/dogmatiq/dosh"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+// reverseOrder is a SplitStrategy used to test custom strategies.
+type reverseOrder struct {
+	valid bool
+}
+
+func (s reverseOrder) Order(n int) []int {
+	if !s.valid {
+		return []int{0, 0}
+	}
+
+	order := make([]int, n)
+	for i := range order {
+		order[i] = n - 1 - i
+	}
+	return order
+}
+
+var _ = Describe("type Amount (split methods)", func() {
+	Describe("func Split()", func() {
+		DescribeTable(
+			"it splits the amount into equal parts",
+			func(a Amount, n int, s SplitStrategy, expect []string) {
+				parts, err := a.Split(n, s)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(magnitudes(parts)).To(Equal(expect))
+				Expect(Sum(parts...).EqualTo(a)).To(BeTrue())
+			},
+			Entry("even split", FromInt("USD", 90), 3, FirstParts, []string{"30", "30", "30"}),
+			Entry("FirstParts", FromInt("USD", 100), 3, FirstParts, []string{"33.34", "33.33", "33.33"}),
+			Entry("FirstParts, several leftover units", FromString("USD", "0.05"), 3, FirstParts, []string{"0.02", "0.02", "0.01"}),
+			Entry("LastParts", FromInt("USD", 100), 3, LastParts, []string{"33.33", "33.33", "33.34"}),
+			Entry("LastParts, several leftover units", FromString("USD", "0.05"), 3, LastParts, []string{"0.01", "0.02", "0.02"}),
+			Entry("nil strategy uses FirstParts", FromInt("USD", 100), 3, nil, []string{"33.34", "33.33", "33.33"}),
+			Entry("custom strategy", FromInt("USD", 100), 3, reverseOrder{valid: true}, []string{"33.33", "33.33", "33.34"}),
+			Entry("single part", FromString("USD", "1.23"), 1, FirstParts, []string{"1.23"}),
+			Entry("zero-decimal currency", FromInt("JPY", 100), 3, FirstParts, []string{"34", "33", "33"}),
+			Entry("one minor unit per part", FromInt("JPY", 3), 3, FirstParts, []string{"1", "1", "1"}),
+			Entry("negative amount", FromInt("USD", -100), 3, FirstParts, []string{"-33.34", "-33.33", "-33.33"}),
+			Entry("negative amount, LastParts", FromInt("USD", -100), 3, LastParts, []string{"-33.33", "-33.33", "-33.34"}),
+			Entry("zero amount", Zero("USD"), 2, FirstParts, []string{"0", "0"}),
+		)
+
+		It("produces the same split for the same seed", func() {
+			a := FromString("USD", "1.05")
+
+			x, err := a.Split(10, SeededParts(42))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			y, err := a.Split(10, SeededParts(42))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(magnitudes(x)).To(Equal(magnitudes(y)))
+			Expect(Sum(x...).EqualTo(a)).To(BeTrue())
+		})
+
+		DescribeTable(
+			"it produces the same permutation for a given seed in every release",
+			func(seed uint64, expect []int) {
+				Expect(SeededParts(seed).Order(10)).To(Equal(expect))
+			},
+			Entry("seed 0", uint64(0), []int{6, 3, 2, 9, 8, 1, 4, 7, 0, 5}),
+			Entry("seed 42", uint64(42), []int{0, 9, 5, 8, 6, 4, 7, 2, 1, 3}),
+		)
+
+		It("distributes leftover units according to the fixed permutation", func() {
+			parts, err := FromString("USD", "1.03").Split(10, SeededParts(42))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(magnitudes(parts)).To(Equal([]string{
+				"0.11", "0.1", "0.1", "0.1", "0.1", "0.11", "0.1", "0.1", "0.1", "0.11",
+			}))
+		})
+
+		It("distributes leftover units to different parts for different seeds", func() {
+			a := FromString("USD", "1.01")
+			indices := map[int]bool{}
+
+			for seed := uint64(0); seed < 20; seed++ {
+				parts, err := a.Split(10, SeededParts(seed))
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(Sum(parts...).EqualTo(a)).To(BeTrue())
+
+				for i, p := range parts {
+					if p.EqualTo(FromString("USD", "0.11")) {
+						indices[i] = true
+					}
+				}
+			}
+
+			Expect(len(indices)).To(BeNumerically(">", 1))
+		})
+
+		DescribeTable(
+			"it returns an error if the amount can not be split",
+			func(a Amount, n int, expect string) {
+				_, err := a.Split(n, FirstParts)
+				Expect(err).To(MatchError(expect))
+			},
+			Entry("zero-decimal currency, fewer units than parts", FromInt("JPY", 2), 3, "cannot split JPY amount into 3 parts: each part would be less than the minor unit"),
+			Entry("fewer units than parts", FromString("USD", "-0.02"), 3, "cannot split USD amount into 3 parts: each part would be less than the minor unit"),
+			Entry("not a whole number of minor units", FromString("USD", "10.005"), 2, "cannot split USD amount into 2 parts: amount is not a whole number of minor units"),
+			Entry("currency with no minor unit", FromInt("XAU", 10), 2, "cannot split XAU amount into 2 parts: currency has no minor unit"),
+		)
+
+		It("panics if the number of parts is not positive", func() {
+			Expect(func() {
+				FromInt("USD", 10).Split(0, FirstParts)
+			}).To(PanicWith("number of parts (0) must be positive"))
+		})
+
+		It("panics if the strategy returns an invalid permutation", func() {
+			Expect(func() {
+				FromInt("USD", 10).Split(2, reverseOrder{})
+			}).To(PanicWith("split strategy returned an invalid permutation ([0 0])"))
+		})
+	})
+})
@@ -0,0 +1,107 @@
+package dosh
+
+import (
+	"fmt"
+	"strings"
+	"sync/atomic"
+
+	"github.com/dogmatiq/dosh/currency"
+)
+
+// TextFormat is a style of text representation for an Amount.
+type TextFormat int
+
+const (
+	// CompactText renders the magnitude with as few decimal places as
+	// possible, such that trailing zeros are omitted. For example, "USD 10.1".
+	// It is the format used by Amount.String(), and by default, by
+	// Amount.MarshalText().
+	CompactText TextFormat = iota
+
+	// MinorUnitText renders the magnitude with at least as many decimal places
+	// as are used by the currency's minor unit. For example, "USD 10.10" or
+	// "JPY 100". Non-zero digits beyond the minor unit are never discarded,
+	// such that "USD 10.125" is rendered unchanged.
+	//
+	// If the currency is not known, or the concept of a minor unit is not
+	// applicable to the currency, it is equivalent to CompactText.
+	MinorUnitText
+
+	// ExponentText is like MinorUnitText, but additionally preserves the
+	// decimal places of the magnitude, including any trailing zeros. For
+	// example, an amount parsed from "USD 10.1000" is rendered as
+	// "USD 10.1000".
+	ExponentText
+)
+
+// String returns the name of the text format.
+func (f TextFormat) String() string {
+	switch f {
+	case CompactText:
+		return "CompactText"
+	case MinorUnitText:
+		return "MinorUnitText"
+	case ExponentText:
+		return "ExponentText"
+	default:
+		return fmt.Sprintf("TextFormat(%d)", int(f))
+	}
+}
+
+// textFormat is the format used by Amount.MarshalText().
+var textFormat atomic.Int32
+
+// SetTextFormat sets the format used by Amount.MarshalText(), returning the
+// format that was previously in effect.
+//
+// The default is CompactText. All formats can be unmarshaled by
+// Amount.UnmarshalText().
+//
+// The text format applies to the entire process. It is intended to be called
+// once, when the application starts.
+//
+// It panics if f is not a recognized format.
+func SetTextFormat(f TextFormat) (prev TextFormat) {
+	switch f {
+	case CompactText, MinorUnitText, ExponentText:
+	default:
+		panic(fmt.Sprintf("unrecognized text format (%d)", int(f)))
+	}
+
+	return TextFormat(textFormat.Swap(int32(f)))
+}
+
+// Text returns a human-readable representation of the amount, including the
+// currency code, using the given format.
+//
+// It panics if f is not a recognized format.
+func (a Amount) Text(f TextFormat) string {
+	c := a.CurrencyCode()
+	if c == "" {
+		c = "<no currency>"
+	}
+
+	m := a.mag.String()
+
+	var places int32
+	switch f {
+	case CompactText:
+		return c + " " + m
+	case MinorUnitText:
+	case ExponentText:
+		places = -a.mag.Exponent()
+	default:
+		panic(fmt.Sprintf("unrecognized text format (%d)", int(f)))
+	}
+
+	if i, ok := currency.Lookup(c); ok && i.HasMinorUnits() {
+		places = max(places, int32(i.MinorUnits))
+	}
+
+	// Never discard non-zero digits beyond the minimum number of places.
+	if n := strings.IndexByte(m, '.'); n != -1 {
+		places = max(places, int32(len(m)-n-1))
+	}
+
+	return c + " " + a.mag.StringFixed(places)
+}
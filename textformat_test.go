@@ -0,0 +1,85 @@
+package dosh_test
+
+import (
+	. "github.com/dogmatiq/dosh"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("type Amount (text formats)", func() {
+	Describe("func Text()", func() {
+		DescribeTable(
+			"it returns a representation of the amount in the given format",
+			func(f TextFormat, a Amount, expect string) {
+				Expect(a.Text(f)).To(Equal(expect))
+			},
+			Entry("CompactText", CompactText, FromString("USD", "10.10"), "USD 10.1"),
+			Entry("MinorUnitText, padded", MinorUnitText, FromString("USD", "10.1"), "USD 10.10"),
+			Entry("MinorUnitText, integer", MinorUnitText, FromString("USD", "10"), "USD 10.00"),
+			Entry("MinorUnitText, zero", MinorUnitText, Zero("USD"), "USD 0.00"),
+			Entry("MinorUnitText, negative", MinorUnitText, FromString("USD", "-0.5"), "USD -0.50"),
+			Entry("MinorUnitText, no minor unit", MinorUnitText, FromString("JPY", "100.00"), "JPY 100"),
+			Entry("MinorUnitText, three places", MinorUnitText, FromString("BHD", "1.5"), "BHD 1.500"),
+			Entry("MinorUnitText, digits beyond the minor unit", MinorUnitText, FromString("USD", "10.125"), "USD 10.125"),
+			Entry("MinorUnitText, trailing zeros beyond the minor unit", MinorUnitText, FromString("USD", "10.1000"), "USD 10.10"),
+			Entry("MinorUnitText, unknown currency", MinorUnitText, FromString("XYZ", "10.10"), "XYZ 10.1"),
+			Entry("MinorUnitText, precious metal", MinorUnitText, FromString("XAU", "1.50"), "XAU 1.5"),
+			Entry("ExponentText, trailing zeros beyond the minor unit", ExponentText, FromString("USD", "10.1000"), "USD 10.1000"),
+			Entry("ExponentText, fewer places than the minor unit", ExponentText, FromString("USD", "10.1"), "USD 10.10"),
+			Entry("ExponentText, JPY with decimal places", ExponentText, FromString("JPY", "100.00"), "JPY 100.00"),
+			Entry("ExponentText, unknown currency", ExponentText, FromString("XYZ", "10.10"), "XYZ 10.10"),
+		)
+
+		It("panics if the format is not recognized", func() {
+			Expect(func() {
+				Unit("USD").Text(TextFormat(100))
+			}).To(PanicWith("unrecognized text format (100)"))
+		})
+	})
+})
+
+var _ = Describe("func SetTextFormat()", func() {
+	It("returns the previous format", func() {
+		prev := SetTextFormat(ExponentText)
+		defer SetTextFormat(prev)
+
+		Expect(prev).To(Equal(CompactText))
+		Expect(SetTextFormat(MinorUnitText)).To(Equal(ExponentText))
+	})
+
+	It("produces text that can be unmarshaled", func() {
+		prev := SetTextFormat(MinorUnitText)
+		defer SetTextFormat(prev)
+
+		a := FromString("USD", "10.1")
+		data, err := a.MarshalText()
+		Expect(err).ShouldNot(HaveOccurred())
+
+		var b Amount
+		err = b.UnmarshalText(data)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(b.EqualTo(a)).To(BeTrue())
+	})
+
+	It("panics if the format is not recognized", func() {
+		Expect(func() {
+			SetTextFormat(TextFormat(100))
+		}).To(PanicWith("unrecognized text format (100)"))
+	})
+})
+
+var _ = Describe("type TextFormat", func() {
+	Describe("func String()", func() {
+		DescribeTable(
+			"it returns the name of the format",
+			func(f TextFormat, expect string) {
+				Expect(f.String()).To(Equal(expect))
+			},
+			Entry("CompactText", CompactText, "CompactText"),
+			Entry("MinorUnitText", MinorUnitText, "MinorUnitText"),
+			Entry("ExponentText", ExponentText, "ExponentText"),
+			Entry("unrecognized", TextFormat(100), "TextFormat(100)"),
+		)
+	})
+})